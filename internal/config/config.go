@@ -1,26 +1,143 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
+
+	apperrors "github.com/taikicoco/shiraberu/internal/errors"
+	"github.com/taikicoco/shiraberu/internal/holiday"
+	"github.com/taikicoco/shiraberu/internal/period"
+	"github.com/taikicoco/shiraberu/internal/timezone"
 )
 
+const envPrefix = "SHIRABERU_"
+
 type Config struct {
-	Org       string
+	// Profile は設定プロファイル名（SHIRABERU_PROFILE）
+	Profile string
+	// Orgs は集計対象のOrganization（SHIRABERU_ORG にカンマ区切りで複数指定可）
+	Orgs      []string
 	Format    string
 	OutputDir string
+	// GitHubHost はGitHubのホスト名（空の場合はgithub.com）
+	GitHubHost string
+	// GitHubToken はHTTP通信に使用するトークン（空の場合は GH_TOKEN/GITHUB_TOKEN、次に gh CLI を使用）
+	GitHubToken string
+	// GitHubAPIURL はHTTP通信のAPIベースURL（空の場合はホスト名から決定）
+	GitHubAPIURL string
+	// DraftMode はDraft判定の基準（"creation": 作成時点 / "fetch": 取得時点）
+	DraftMode string
+	// IncludeLabels / ExcludeLabels はラベルによるPRの絞り込み条件（カンマ区切り、area/* のようなパターンも可）
+	IncludeLabels []string
+	ExcludeLabels []string
+	// Timezone は日付の区切りに使用するIANAタイムゾーン名（空の場合はローカルタイムゾーン）
+	Timezone string
+	// Location は Timezone から解決したタイムゾーン
+	Location *time.Location
+	// WeekStart は週の開始曜日（例: monday, sunday）
+	WeekStart string
+	// FiscalYearStart は会計年度の開始月（例: 4, april）
+	FiscalYearStart string
+	// Calendar は WeekStart と FiscalYearStart から組み立てた期間計算の基準
+	Calendar *period.Calendar
+	// Compare は差分の比較対象（"previous"、"year-ago" または期間式）
+	Compare string
+	// TrendPeriods は比較のために取得する直近の過去期間の数（2以上で推移を表示）
+	TrendPeriods int
+	// HolidayFiles は休日カレンダーのファイル（.ics / .yaml、カンマ区切りで複数指定可）
+	HolidayFiles []string
+	// Workdays は稼働曜日（例: mon-fri, mon,tue,wed,thu）
+	Workdays string
+	// BusinessCalendar は HolidayFiles と Workdays から組み立てた稼働日の判定基準
+	BusinessCalendar *holiday.Calendar
+	// MarkdownSections は Markdown 出力に含めるセクション（カンマ区切り、空の場合は全て）
+	MarkdownSections string
+	// MarkdownCharts は Markdown 出力への SVG グラフの組み込み方（none, files, inline）
+	MarkdownCharts string
+	// Template は HTML / Markdown 出力に使用するテンプレートのファイルまたはディレクトリ（空の場合は組み込み）
+	Template string
+	// SlackWebhookURL は Slack 形式の出力を送信する Incoming Webhook の URL（空の場合は送信しない）
+	SlackWebhookURL string
+	// ChartJS は HTML レポートへの Chart.js の組み込み方（inline または cdn）。空の場合は render のデフォルトを使用する
+	ChartJS string
 }
 
+// Load は環境変数（および .env ファイル）から設定を読み込む。
+// SHIRABERU_PROFILE が設定されている場合、SHIRABERU_<PROFILE>_<KEY> が SHIRABERU_<KEY> より優先される
 func Load() (*Config, error) {
 	// .env ファイルを読み込み（存在しなくてもエラーにしない）
 	_ = godotenv.Load()
 
+	profile := os.Getenv("SHIRABERU_PROFILE")
+
 	cfg := &Config{
-		Org:       os.Getenv("SHIRABERU_ORG"),
-		Format:    getEnvOrDefault("SHIRABERU_FORMAT", "markdown"),
-		OutputDir: getEnvOrDefault("SHIRABERU_OUTPUT_DIR", "./output"),
+		Profile:   profile,
+		Orgs:      SplitList(getProfileEnv(profile, "ORG")),
+		Format:    getProfileEnvOrDefault(profile, "FORMAT", "markdown"),
+		OutputDir: getProfileEnvOrDefault(profile, "OUTPUT_DIR", "./output"),
+
+		GitHubHost:   getProfileEnvOrDefault(profile, "GITHUB_HOST", os.Getenv("GH_HOST")),
+		GitHubToken:  getProfileEnv(profile, "GITHUB_TOKEN"),
+		GitHubAPIURL: getProfileEnv(profile, "GITHUB_API_URL"),
+
+		DraftMode: getProfileEnvOrDefault(profile, "DRAFT_MODE", "creation"),
+
+		IncludeLabels: SplitList(getProfileEnv(profile, "INCLUDE_LABELS")),
+		ExcludeLabels: SplitList(getProfileEnv(profile, "EXCLUDE_LABELS")),
+
+		Timezone:  getProfileEnv(profile, "TIMEZONE"),
+		WeekStart: getProfileEnvOrDefault(profile, "WEEK_START", "monday"),
+
+		FiscalYearStart: getProfileEnvOrDefault(profile, "FISCAL_YEAR_START", "1"),
+
+		Compare: getProfileEnvOrDefault(profile, "COMPARE", "previous"),
+
+		HolidayFiles: SplitList(getProfileEnv(profile, "HOLIDAYS")),
+		Workdays:     getProfileEnvOrDefault(profile, "WORKDAYS", "mon-fri"),
+
+		MarkdownSections: getProfileEnv(profile, "MARKDOWN_SECTIONS"),
+		MarkdownCharts:   getProfileEnvOrDefault(profile, "MARKDOWN_CHARTS", "none"),
+		Template:         getProfileEnv(profile, "TEMPLATE"),
+		ChartJS:          getProfileEnv(profile, "CHARTJS"),
+		SlackWebhookURL:  getProfileEnv(profile, "SLACK_WEBHOOK_URL"),
+	}
+
+	trendPeriods := getProfileEnvOrDefault(profile, "TREND_PERIODS", "1")
+	n, err := strconv.Atoi(trendPeriods)
+	if err != nil || n < 1 {
+		return nil, fmt.Errorf("%w: TREND_PERIODS must be a positive integer, got %q", apperrors.ErrInvalidConfig, trendPeriods)
+	}
+	cfg.TrendPeriods = n
+
+	if cfg.DraftMode != "creation" && cfg.DraftMode != "fetch" {
+		return nil, fmt.Errorf("%w: DRAFT_MODE must be \"creation\" or \"fetch\", got %q", apperrors.ErrInvalidConfig, cfg.DraftMode)
+	}
+
+	loc, err := timezone.Load(cfg.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("%w: TIMEZONE: %v", apperrors.ErrInvalidConfig, err)
+	}
+	cfg.Location = loc
+
+	weekStart, err := period.ParseWeekday(cfg.WeekStart)
+	if err != nil {
+		return nil, fmt.Errorf("%w: WEEK_START: %v", apperrors.ErrInvalidConfig, err)
+	}
+	fiscalYearStart, err := period.ParseMonth(cfg.FiscalYearStart)
+	if err != nil {
+		return nil, fmt.Errorf("%w: FISCAL_YEAR_START: %v", apperrors.ErrInvalidConfig, err)
+	}
+	cfg.Calendar = &period.Calendar{WeekStart: weekStart, FiscalYearStart: fiscalYearStart}
+
+	if err := cfg.loadBusinessCalendar(); err != nil {
+		return nil, err
 	}
 
 	if cfg.OutputDir != "" {
@@ -35,9 +152,58 @@ func Load() (*Config, error) {
 	return cfg, nil
 }
 
+// SetLocation はタイムゾーンを変更する。iCalendar の日時指定の休日はタイムゾーンによって日付が変わるため、休日ファイルも読み込み直す
+func (c *Config) SetLocation(name string, loc *time.Location) error {
+	c.Timezone, c.Location = name, loc
+	return c.loadBusinessCalendar()
+}
+
+// loadBusinessCalendar は Workdays と HolidayFiles から BusinessCalendar を組み立てる
+func (c *Config) loadBusinessCalendar() error {
+	workdays, err := holiday.ParseWorkdays(c.Workdays)
+	if err != nil {
+		return fmt.Errorf("%w: WORKDAYS: %v", apperrors.ErrInvalidConfig, err)
+	}
+	holidays, err := holiday.LoadFiles(c.HolidayFiles, c.Location)
+	if err != nil {
+		return fmt.Errorf("%w: HOLIDAYS: %v", apperrors.ErrInvalidConfig, err)
+	}
+	c.BusinessCalendar = holiday.New(workdays, holidays)
+	return nil
+}
+
 func getEnvOrDefault(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
 	}
 	return defaultValue
 }
+
+// SplitList はカンマ区切りの値を空要素と重複を除いて分割する。
+// Organization やラベルの名前は大文字小文字を区別しないため、重複は大文字小文字を無視して判定し最初の表記を残す
+func SplitList(value string) []string {
+	var result []string
+	for _, v := range strings.Split(value, ",") {
+		v = strings.TrimSpace(v)
+		if v == "" || slices.ContainsFunc(result, func(s string) bool { return strings.EqualFold(s, v) }) {
+			continue
+		}
+		result = append(result, v)
+	}
+	return result
+}
+
+// getProfileEnv はプロファイル固有の環境変数を優先して設定値を返す
+func getProfileEnv(profile, key string) string {
+	return getProfileEnvOrDefault(profile, key, "")
+}
+
+func getProfileEnvOrDefault(profile, key, defaultValue string) string {
+	if profile != "" {
+		profileKey := envPrefix + strings.ToUpper(strings.ReplaceAll(profile, "-", "_")) + "_" + key
+		if value := os.Getenv(profileKey); value != "" {
+			return value
+		}
+	}
+	return getEnvOrDefault(envPrefix+key, defaultValue)
+}
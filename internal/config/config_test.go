@@ -1,9 +1,14 @@
 package config
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
+
+	apperrors "github.com/taikicoco/shiraberu/internal/errors"
 )
 
 func TestLoad_Defaults(t *testing.T) {
@@ -24,8 +29,8 @@ func TestLoad_Defaults(t *testing.T) {
 	if cfg.OutputDir != "./output" {
 		t.Errorf("OutputDir: got %q, want %q", cfg.OutputDir, "./output")
 	}
-	if cfg.Org != "" {
-		t.Errorf("Org: got %q, want empty", cfg.Org)
+	if len(cfg.Orgs) != 0 {
+		t.Errorf("Orgs: got %q, want empty", cfg.Orgs)
 	}
 }
 
@@ -51,8 +56,8 @@ func TestLoad_WithEnvVars(t *testing.T) {
 		t.Fatalf("Load() failed: %v", err)
 	}
 
-	if cfg.Org != "test-org" {
-		t.Errorf("Org: got %q, want %q", cfg.Org, "test-org")
+	if len(cfg.Orgs) != 1 || cfg.Orgs[0] != "test-org" {
+		t.Errorf("Orgs: got %q, want [test-org]", cfg.Orgs)
 	}
 	if cfg.Format != "html" {
 		t.Errorf("Format: got %q, want %q", cfg.Format, "html")
@@ -130,3 +135,385 @@ func TestGetEnvOrDefault(t *testing.T) {
 		})
 	}
 }
+
+func TestLoad_GitHubSettings(t *testing.T) {
+	t.Setenv("SHIRABERU_OUTPUT_DIR", t.TempDir())
+	t.Setenv("SHIRABERU_GITHUB_TOKEN", "test-token")
+	t.Setenv("SHIRABERU_GITHUB_API_URL", "http://localhost:8080")
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+
+	if cfg.GitHubToken != "test-token" {
+		t.Errorf("GitHubToken: got %q, want %q", cfg.GitHubToken, "test-token")
+	}
+	if cfg.GitHubAPIURL != "http://localhost:8080" {
+		t.Errorf("GitHubAPIURL: got %q, want %q", cfg.GitHubAPIURL, "http://localhost:8080")
+	}
+}
+
+func TestLoad_GitHubHost(t *testing.T) {
+	t.Setenv("SHIRABERU_OUTPUT_DIR", t.TempDir())
+	t.Setenv("SHIRABERU_PROFILE", "")
+	t.Setenv("SHIRABERU_GITHUB_HOST", "")
+	t.Setenv("GH_HOST", "gh-host.example.com")
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if cfg.GitHubHost != "gh-host.example.com" {
+		t.Errorf("GitHubHost: got %q, want GH_HOST fallback %q", cfg.GitHubHost, "gh-host.example.com")
+	}
+
+	t.Setenv("SHIRABERU_GITHUB_HOST", "ghe.example.com")
+	cfg, err = Load()
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if cfg.GitHubHost != "ghe.example.com" {
+		t.Errorf("GitHubHost: got %q, want %q", cfg.GitHubHost, "ghe.example.com")
+	}
+}
+
+func TestLoad_Profile(t *testing.T) {
+	t.Setenv("SHIRABERU_OUTPUT_DIR", t.TempDir())
+	t.Setenv("SHIRABERU_PROFILE", "my-work")
+	t.Setenv("SHIRABERU_ORG", "public-org")
+	t.Setenv("SHIRABERU_MY_WORK_ORG", "enterprise-org")
+	t.Setenv("SHIRABERU_GITHUB_HOST", "")
+	t.Setenv("SHIRABERU_MY_WORK_GITHUB_HOST", "ghe.example.com")
+	t.Setenv("SHIRABERU_FORMAT", "html")
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+
+	if cfg.Profile != "my-work" {
+		t.Errorf("Profile: got %q, want %q", cfg.Profile, "my-work")
+	}
+	if len(cfg.Orgs) != 1 || cfg.Orgs[0] != "enterprise-org" {
+		t.Errorf("Orgs: got %q, want profile value [enterprise-org]", cfg.Orgs)
+	}
+	if cfg.GitHubHost != "ghe.example.com" {
+		t.Errorf("GitHubHost: got %q, want profile value %q", cfg.GitHubHost, "ghe.example.com")
+	}
+	// プロファイル固有の値がない場合は共通の値を使用する
+	if cfg.Format != "html" {
+		t.Errorf("Format: got %q, want fallback %q", cfg.Format, "html")
+	}
+}
+
+func TestLoad_DraftMode(t *testing.T) {
+	t.Setenv("SHIRABERU_OUTPUT_DIR", t.TempDir())
+	t.Setenv("SHIRABERU_PROFILE", "")
+
+	tests := []struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{"", "creation", false},
+		{"creation", "creation", false},
+		{"fetch", "fetch", false},
+		{"sometimes", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			t.Setenv("SHIRABERU_DRAFT_MODE", tt.value)
+
+			cfg, err := Load()
+			if tt.wantErr {
+				if !errors.Is(err, apperrors.ErrInvalidConfig) {
+					t.Errorf("error: got %v, want ErrInvalidConfig", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Load() failed: %v", err)
+			}
+			if cfg.DraftMode != tt.want {
+				t.Errorf("DraftMode: got %q, want %q", cfg.DraftMode, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoad_Labels(t *testing.T) {
+	t.Setenv("SHIRABERU_OUTPUT_DIR", t.TempDir())
+	t.Setenv("SHIRABERU_PROFILE", "")
+	t.Setenv("SHIRABERU_INCLUDE_LABELS", "type/bug, area/* ,")
+	t.Setenv("SHIRABERU_EXCLUDE_LABELS", "wip")
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+
+	if len(cfg.IncludeLabels) != 2 || cfg.IncludeLabels[0] != "type/bug" || cfg.IncludeLabels[1] != "area/*" {
+		t.Errorf("IncludeLabels: got %q, want [type/bug area/*]", cfg.IncludeLabels)
+	}
+	if len(cfg.ExcludeLabels) != 1 || cfg.ExcludeLabels[0] != "wip" {
+		t.Errorf("ExcludeLabels: got %q, want [wip]", cfg.ExcludeLabels)
+	}
+}
+
+func TestLoad_MultipleOrgs(t *testing.T) {
+	t.Setenv("SHIRABERU_OUTPUT_DIR", t.TempDir())
+	t.Setenv("SHIRABERU_PROFILE", "")
+	t.Setenv("SHIRABERU_ORG", "org-a, org-b,org-c,org-a, ORG-B")
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+
+	want := []string{"org-a", "org-b", "org-c"}
+	if strings.Join(cfg.Orgs, ",") != strings.Join(want, ",") {
+		t.Errorf("Orgs: got %q, want %q", cfg.Orgs, want)
+	}
+}
+
+func TestLoad_Timezone(t *testing.T) {
+	t.Setenv("SHIRABERU_OUTPUT_DIR", t.TempDir())
+	t.Setenv("SHIRABERU_PROFILE", "")
+
+	t.Setenv("SHIRABERU_TIMEZONE", "")
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if cfg.Location != time.Local {
+		t.Errorf("Location: got %v, want Local by default", cfg.Location)
+	}
+
+	t.Setenv("SHIRABERU_TIMEZONE", "America/Los_Angeles")
+	cfg, err = Load()
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if cfg.Location.String() != "America/Los_Angeles" {
+		t.Errorf("Location: got %v, want America/Los_Angeles", cfg.Location)
+	}
+
+	t.Setenv("SHIRABERU_TIMEZONE", "Nowhere/Special")
+	if _, err := Load(); !errors.Is(err, apperrors.ErrInvalidConfig) {
+		t.Errorf("error: got %v, want ErrInvalidConfig", err)
+	}
+}
+
+func TestLoad_WeekStart(t *testing.T) {
+	t.Setenv("SHIRABERU_OUTPUT_DIR", t.TempDir())
+	t.Setenv("SHIRABERU_PROFILE", "")
+
+	tests := []struct {
+		name    string
+		value   string
+		want    time.Weekday
+		wantErr bool
+	}{
+		{"default", "", time.Monday, false},
+		{"sunday", "sunday", time.Sunday, false},
+		{"abbreviation", "Sun", time.Sunday, false},
+		{"invalid", "funday", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("SHIRABERU_WEEK_START", tt.value)
+			cfg, err := Load()
+			if tt.wantErr {
+				if !errors.Is(err, apperrors.ErrInvalidConfig) {
+					t.Errorf("error: got %v, want ErrInvalidConfig", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Load() failed: %v", err)
+			}
+			if cfg.Calendar.WeekStart != tt.want {
+				t.Errorf("WeekStart: got %v, want %v", cfg.Calendar.WeekStart, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoad_FiscalYearStart(t *testing.T) {
+	t.Setenv("SHIRABERU_OUTPUT_DIR", t.TempDir())
+	t.Setenv("SHIRABERU_PROFILE", "")
+
+	tests := []struct {
+		name    string
+		value   string
+		want    time.Month
+		wantErr bool
+	}{
+		{"default", "", time.January, false},
+		{"number", "4", time.April, false},
+		{"name", "april", time.April, false},
+		{"out of range", "13", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("SHIRABERU_FISCAL_YEAR_START", tt.value)
+			cfg, err := Load()
+			if tt.wantErr {
+				if !errors.Is(err, apperrors.ErrInvalidConfig) {
+					t.Errorf("error: got %v, want ErrInvalidConfig", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Load() failed: %v", err)
+			}
+			if cfg.Calendar.FiscalYearStart != tt.want {
+				t.Errorf("FiscalYearStart: got %v, want %v", cfg.Calendar.FiscalYearStart, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoad_TrendPeriods(t *testing.T) {
+	t.Setenv("SHIRABERU_OUTPUT_DIR", t.TempDir())
+	t.Setenv("SHIRABERU_PROFILE", "")
+
+	tests := []struct {
+		name    string
+		value   string
+		want    int
+		wantErr bool
+	}{
+		{"default", "", 1, false},
+		{"six periods", "6", 6, false},
+		{"zero", "0", 0, true},
+		{"not a number", "many", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("SHIRABERU_TREND_PERIODS", tt.value)
+			cfg, err := Load()
+			if tt.wantErr {
+				if !errors.Is(err, apperrors.ErrInvalidConfig) {
+					t.Errorf("error: got %v, want ErrInvalidConfig", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Load() failed: %v", err)
+			}
+			if cfg.TrendPeriods != tt.want {
+				t.Errorf("TrendPeriods: got %d, want %d", cfg.TrendPeriods, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoad_Compare(t *testing.T) {
+	t.Setenv("SHIRABERU_OUTPUT_DIR", t.TempDir())
+	t.Setenv("SHIRABERU_PROFILE", "")
+
+	t.Setenv("SHIRABERU_COMPARE", "")
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if cfg.Compare != "previous" {
+		t.Errorf("Compare: got %q, want %q", cfg.Compare, "previous")
+	}
+
+	t.Setenv("SHIRABERU_COMPARE", "year-ago")
+	cfg, err = Load()
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if cfg.Compare != "year-ago" {
+		t.Errorf("Compare: got %q, want %q", cfg.Compare, "year-ago")
+	}
+}
+
+func TestLoad_BusinessCalendar(t *testing.T) {
+	t.Setenv("SHIRABERU_OUTPUT_DIR", t.TempDir())
+	t.Setenv("SHIRABERU_PROFILE", "")
+
+	holidayFile := filepath.Join(t.TempDir(), "holidays.yaml")
+	if err := os.WriteFile(holidayFile, []byte("holidays:\n  - date: 2025-01-01\n    name: New Year's Day\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		workdays string
+		holidays string
+		date     time.Time
+		want     bool
+		wantErr  bool
+	}{
+		{"default weekday", "", "", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), true, false},
+		{"default saturday", "", "", time.Date(2025, 1, 4, 0, 0, 0, 0, time.UTC), false, false},
+		{"holiday", "", holidayFile, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), false, false},
+		{"sunday to thursday", "sun-thu", "", time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC), true, false},
+		{"invalid workdays", "funday", "", time.Time{}, false, true},
+		{"missing holiday file", "", filepath.Join(t.TempDir(), "missing.ics"), time.Time{}, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("SHIRABERU_WORKDAYS", tt.workdays)
+			t.Setenv("SHIRABERU_HOLIDAYS", tt.holidays)
+			cfg, err := Load()
+			if tt.wantErr {
+				if !errors.Is(err, apperrors.ErrInvalidConfig) {
+					t.Errorf("error: got %v, want ErrInvalidConfig", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Load() failed: %v", err)
+			}
+			if got := cfg.BusinessCalendar.IsBusinessDay(tt.date); got != tt.want {
+				t.Errorf("IsBusinessDay(%s): got %v, want %v", tt.date.Format("2006-01-02"), got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfig_SetLocation(t *testing.T) {
+	t.Setenv("SHIRABERU_OUTPUT_DIR", t.TempDir())
+	t.Setenv("SHIRABERU_PROFILE", "")
+	t.Setenv("SHIRABERU_TIMEZONE", "UTC")
+	t.Setenv("SHIRABERU_WORKDAYS", "")
+
+	holidayFile := filepath.Join(t.TempDir(), "holidays.ics")
+	if err := os.WriteFile(holidayFile, []byte("BEGIN:VEVENT\nDTSTART:20250720T150000Z\nSUMMARY:Marine Day\nEND:VEVENT\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("SHIRABERU_HOLIDAYS", holidayFile)
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if _, ok := cfg.BusinessCalendar.HolidayName(time.Date(2025, 7, 20, 0, 0, 0, 0, time.UTC)); !ok {
+		t.Error("holiday should be on 2025-07-20 in UTC")
+	}
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cfg.SetLocation("Asia/Tokyo", tokyo); err != nil {
+		t.Fatalf("SetLocation failed: %v", err)
+	}
+	if cfg.Timezone != "Asia/Tokyo" || cfg.Location != tokyo {
+		t.Errorf("timezone: got %q", cfg.Timezone)
+	}
+	if _, ok := cfg.BusinessCalendar.HolidayName(time.Date(2025, 7, 21, 0, 0, 0, 0, tokyo)); !ok {
+		t.Error("holiday should move to 2025-07-21 in Asia/Tokyo")
+	}
+}
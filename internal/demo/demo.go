@@ -37,10 +37,21 @@ var prTitles = []string{
 	"refactor: Improve error handling",
 }
 
+var typeLabels = []string{"type/bug", "type/feature", "type/refactor"}
+
+var areaLabels = []string{"area/api", "area/web", "area/infra"}
+
+var reviewStates = []string{
+	github.ReviewApproved,
+	github.ReviewChangesRequested,
+	github.ReviewCommented,
+}
+
 // Demo generation constants
 const (
 	weekendActivityRate = 0.3 // Probability of activity on weekends
 	draftPRRate         = 0.3 // Probability of generating a draft PR (1 - 0.7)
+	closedPRRate        = 0.1 // Probability of generating a closed (unmerged) PR
 	maxOpenedPRs        = 4   // Max opened PRs per day (0-3)
 	maxMergedPRs        = 5   // Max merged PRs per day (0-4)
 	maxReviewedPRs      = 4   // Max reviewed PRs per day (0-3)
@@ -71,7 +82,7 @@ func generatePeriodReport(startDate, endDate time.Time, r *rand.Rand, org string
 	days := []pr.DailyPRs{}
 
 	for d := startDate; !d.After(endDate); d = d.AddDate(0, 0, 1) {
-		date := time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, timezone.JST)
+		date := time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, startDate.Location())
 
 		// Skip some days randomly (weekends have less activity)
 		weekday := date.Weekday()
@@ -103,14 +114,27 @@ func generatePeriodReport(startDate, endDate time.Time, r *rand.Rand, org string
 			day.Merged = append(day.Merged, p)
 		}
 
+		// Generate closed (unmerged) PRs
+		if r.Float32() < closedPRRate {
+			p := generatePR(r, date, "closed")
+			closedAt := date.Add(time.Duration(r.Intn(24)) * time.Hour)
+			p.ClosedAt = &closedAt
+			day.Closed = append(day.Closed, p)
+		}
+
 		// Generate reviewed PRs
 		reviewedCount := r.Intn(maxReviewedPRs)
 		for i := 0; i < reviewedCount; i++ {
-			day.Reviewed = append(day.Reviewed, generatePR(r, date, "open"))
+			p := generatePR(r, date, "open")
+			p.Reviews = []github.Review{{
+				State:       reviewStates[r.Intn(len(reviewStates))],
+				SubmittedAt: date.Add(time.Duration(r.Intn(24)) * time.Hour),
+			}}
+			day.Reviewed = append(day.Reviewed, p)
 		}
 
 		// Only add day if it has any PRs
-		if len(day.Opened) > 0 || len(day.Draft) > 0 || len(day.Merged) > 0 || len(day.Reviewed) > 0 {
+		if len(day.Opened) > 0 || len(day.Draft) > 0 || len(day.Merged) > 0 || len(day.Closed) > 0 || len(day.Reviewed) > 0 {
 			days = append(days, day)
 		}
 	}
@@ -120,11 +144,14 @@ func generatePeriodReport(startDate, endDate time.Time, r *rand.Rand, org string
 		return days[i].Date.After(days[j].Date)
 	})
 
+	generatedAt := time.Now().In(startDate.Location())
 	return &pr.Report{
-		GeneratedAt: time.Now(),
+		GeneratedAt: generatedAt,
 		StartDate:   startDate,
 		EndDate:     endDate,
-		Org:         org,
+		Host:        github.DefaultHost,
+		Timezone:    timezone.Name(startDate.Location(), generatedAt),
+		Orgs:        []string{org},
 		Username:    "demo-user",
 		Days:        days,
 	}
@@ -139,8 +166,8 @@ func generatePR(r *rand.Rand, date time.Time, state string) github.PullRequest {
 
 	return github.PullRequest{
 		Title:        title,
-		URL:          "https://github.com/demo-org/" + repo + "/pull/" + randomPRNumber(r),
-		Repository:   repo,
+		URL:          "https://" + github.DefaultHost + "/demo-org/" + repo + "/pull/" + randomPRNumber(r),
+		Repository:   "demo-org/" + repo,
 		State:        state,
 		IsDraft:      state == "draft",
 		CreatedAt:    date,
@@ -149,6 +176,7 @@ func generatePR(r *rand.Rand, date time.Time, state string) github.PullRequest {
 		Deletions:    deletions,
 		ChangedFiles: r.Intn(maxChangedFiles) + 1,
 		Comments:     r.Intn(maxComments),
+		Labels:       []string{typeLabels[r.Intn(len(typeLabels))], areaLabels[r.Intn(len(areaLabels))]},
 	}
 }
 
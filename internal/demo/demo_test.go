@@ -16,8 +16,8 @@ func TestGenerateReport(t *testing.T) {
 	if report == nil {
 		t.Fatal("report is nil")
 	}
-	if report.Org != "demo-org" {
-		t.Errorf("Org: got %q, want %q", report.Org, "demo-org")
+	if len(report.Orgs) != 1 || report.Orgs[0] != "demo-org" {
+		t.Errorf("Orgs: got %q, want [demo-org]", report.Orgs)
 	}
 	if !report.StartDate.Equal(startDate) {
 		t.Errorf("StartDate: got %v, want %v", report.StartDate, startDate)
@@ -30,8 +30,8 @@ func TestGenerateReport(t *testing.T) {
 	if previousReport == nil {
 		t.Fatal("previousReport is nil")
 	}
-	if previousReport.Org != "demo-org" {
-		t.Errorf("previousReport.Org: got %q, want %q", previousReport.Org, "demo-org")
+	if len(previousReport.Orgs) != 1 || previousReport.Orgs[0] != "demo-org" {
+		t.Errorf("previousReport.Orgs: got %q, want [demo-org]", previousReport.Orgs)
 	}
 }
 
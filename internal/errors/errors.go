@@ -10,6 +10,9 @@ var (
 
 	// ErrAPIFailed はGitHub API呼び出しが失敗した場合のエラー
 	ErrAPIFailed = errors.New("GitHub API call failed")
+
+	// ErrSearchTruncated は検索結果が上限(1000件)を超え、全件を取得できなかった場合のエラー
+	ErrSearchTruncated = errors.New("search results truncated at the 1000-result limit")
 )
 
 // Sentinel errors for config
@@ -28,4 +31,28 @@ var (
 
 	// ErrOrgRequired は組織名が必須だが空の場合のエラー
 	ErrOrgRequired = errors.New("organization is required")
+
+	// ErrMissingOptions は非対話モードで必須の値が指定されていない場合のエラー
+	ErrMissingOptions = errors.New("required options are missing")
+
+	// ErrInvalidOption はコマンドラインオプションの値が無効な場合のエラー
+	ErrInvalidOption = errors.New("invalid option")
+)
+
+// Sentinel errors for snapshot
+var (
+	// ErrInvalidSnapshot はスナップショットファイルの形式が無効な場合のエラー
+	ErrInvalidSnapshot = errors.New("invalid snapshot")
+)
+
+// Sentinel errors for render
+var (
+	// ErrChartJSMissing は HTML に埋め込む Chart.js のビルドがバイナリに含まれていない場合のエラー
+	ErrChartJSMissing = errors.New("embedded Chart.js is missing")
+)
+
+// Sentinel errors for Slack
+var (
+	// ErrWebhookFailed は Slack の Incoming Webhook への送信が失敗した場合のエラー
+	ErrWebhookFailed = errors.New("Slack webhook call failed")
 )
@@ -32,27 +32,63 @@ func (e *DefaultExecutor) Execute(name string, args ...string) ([]byte, error) {
 }
 
 type Client struct {
-	username string
-	executor CommandExecutor
+	username  string
+	host      string
+	transport Transport
+	executor  CommandExecutor
+	token     string
+	baseURL   string
 }
 
 // ClientOption はClientの設定オプション
 type ClientOption func(*Client)
 
-// WithExecutor はCommandExecutorを設定するオプション
+// WithExecutor はCommandExecutorを設定するオプション（gh CLI経由で通信する）
 func WithExecutor(e CommandExecutor) ClientOption {
 	return func(c *Client) {
 		c.executor = e
 	}
 }
 
-func NewClient(opts ...ClientOption) (*Client, error) {
-	c := &Client{
-		executor: &DefaultExecutor{},
+// WithTransport はTransportを設定するオプション
+func WithTransport(t Transport) ClientOption {
+	return func(c *Client) {
+		c.transport = t
+	}
+}
+
+// WithToken はHTTP通信に使用するトークンを設定するオプション
+func WithToken(token string) ClientOption {
+	return func(c *Client) {
+		c.token = token
+	}
+}
+
+// WithBaseURL はHTTP通信のAPIベースURLを設定するオプション
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) {
+		c.baseURL = baseURL
+	}
+}
+
+// WithHost はGitHubのホスト名を設定するオプション（GitHub Enterprise Server用）
+func WithHost(host string) ClientOption {
+	return func(c *Client) {
+		c.host = host
 	}
+}
+
+// NewClient は新しいClientを作成する。
+// Transportが明示されていない場合、トークン（オプション、GH_TOKEN、GITHUB_TOKENの順）があれば
+// HTTP通信を使用し、なければ gh CLI にフォールバックする
+func NewClient(opts ...ClientOption) (*Client, error) {
+	c := &Client{}
 	for _, opt := range opts {
 		opt(c)
 	}
+	if c.transport == nil {
+		c.transport = c.defaultTransport()
+	}
 
 	username, err := c.getUsername()
 	if err != nil {
@@ -62,139 +98,290 @@ func NewClient(opts ...ClientOption) (*Client, error) {
 	return c, nil
 }
 
+func (c *Client) defaultTransport() Transport {
+	if c.executor != nil {
+		return &ghTransport{executor: c.executor, host: c.host}
+	}
+
+	token := c.token
+	if token == "" {
+		token = tokenFromEnv()
+	}
+	if token != "" {
+		baseURL := c.baseURL
+		if baseURL == "" {
+			baseURL = apiBaseURL(c.Host())
+		}
+		return NewHTTPTransport(baseURL, token)
+	}
+	return &ghTransport{executor: &DefaultExecutor{}, host: c.host}
+}
+
 func (c *Client) Username() string {
 	return c.username
 }
 
+// Host はデータ取得元のGitHubホスト名を返す
+func (c *Client) Host() string {
+	if c.host == "" {
+		return DefaultHost
+	}
+	return c.host
+}
+
 func (c *Client) getUsername() (string, error) {
-	out, err := c.executor.Execute("gh", "api", "user", "--jq", ".login")
+	login, err := c.transport.Login()
 	if err != nil {
 		return "", err
 	}
-	username := strings.TrimSpace(string(out))
+	username := strings.TrimSpace(login)
 	if username == "" {
 		return "", apperrors.ErrEmptyUsername
 	}
 	return username, nil
 }
 
+// searchResultLimit はGitHub検索APIが1クエリで返す最大件数
+const searchResultLimit = 1000
+
+// prFields はPR検索で取得する共通フィールド
+const prFields = `
+fragment prFields on PullRequest {
+  title
+  url
+  state
+  isDraft
+  createdAt
+  mergedAt
+  closedAt
+  updatedAt
+  additions
+  deletions
+  changedFiles
+  comments {
+    totalCount
+  }
+  repository {
+    nameWithOwner
+  }
+  labels(first: 20) {
+    nodes {
+      name
+    }
+  }
+  timelineItems(itemTypes: [READY_FOR_REVIEW_EVENT, CONVERT_TO_DRAFT_EVENT], first: 1) {
+    nodes {
+      __typename
+    }
+  }
+}
+`
+
 // searchQuery is the GraphQL query for searching PRs.
 // Uses 100 results per page for pagination.
 const searchQuery = `
 query($q: String!, $cursor: String) {
   search(query: $q, type: ISSUE, first: 100, after: $cursor) {
+    issueCount
     pageInfo {
       hasNextPage
       endCursor
     }
     nodes {
       ... on PullRequest {
-        title
-        url
-        state
-        isDraft
-        createdAt
-        mergedAt
-        updatedAt
-        additions
-        deletions
-        changedFiles
-        comments {
-          totalCount
-        }
-        repository {
-          name
+        ...prFields
+      }
+    }
+  }
+}
+` + prFields
+
+// reviewSearchQuery はレビューしたPRを、レビュアー自身のレビュー履歴付きで検索するクエリ
+const reviewSearchQuery = `
+query($q: String!, $cursor: String, $reviewer: String!) {
+  search(query: $q, type: ISSUE, first: 100, after: $cursor) {
+    issueCount
+    pageInfo {
+      hasNextPage
+      endCursor
+    }
+    nodes {
+      ... on PullRequest {
+        ...prFields
+        reviews(first: 100, author: $reviewer) {
+          nodes {
+            state
+            submittedAt
+          }
         }
       }
     }
   }
 }
-`
+` + prFields
 
 type graphQLResponse struct {
 	Data struct {
 		Search struct {
-			PageInfo struct {
+			IssueCount int `json:"issueCount"`
+			PageInfo   struct {
 				HasNextPage bool   `json:"hasNextPage"`
 				EndCursor   string `json:"endCursor"`
 			} `json:"pageInfo"`
-			Nodes []struct {
-				Title        string `json:"title"`
-				URL          string `json:"url"`
-				State        string `json:"state"`
-				IsDraft      bool   `json:"isDraft"`
-				CreatedAt    string `json:"createdAt"`
-				MergedAt     string `json:"mergedAt"`
-				UpdatedAt    string `json:"updatedAt"`
-				Additions    int    `json:"additions"`
-				Deletions    int    `json:"deletions"`
-				ChangedFiles int    `json:"changedFiles"`
-				Comments     struct {
-					TotalCount int `json:"totalCount"`
-				} `json:"comments"`
-				Repository struct {
-					Name string `json:"name"`
-				} `json:"repository"`
-			} `json:"nodes"`
+			Nodes []prNode `json:"nodes"`
 		} `json:"search"`
 	} `json:"data"`
 }
 
+// prNode は検索結果のPullRequestノード
+type prNode struct {
+	Title        string `json:"title"`
+	URL          string `json:"url"`
+	State        string `json:"state"`
+	IsDraft      bool   `json:"isDraft"`
+	CreatedAt    string `json:"createdAt"`
+	MergedAt     string `json:"mergedAt"`
+	ClosedAt     string `json:"closedAt"`
+	UpdatedAt    string `json:"updatedAt"`
+	Additions    int    `json:"additions"`
+	Deletions    int    `json:"deletions"`
+	ChangedFiles int    `json:"changedFiles"`
+	Comments     struct {
+		TotalCount int `json:"totalCount"`
+	} `json:"comments"`
+	Repository struct {
+		NameWithOwner string `json:"nameWithOwner"`
+	} `json:"repository"`
+	Labels struct {
+		Nodes []struct {
+			Name string `json:"name"`
+		} `json:"nodes"`
+	} `json:"labels"`
+	// TimelineItems はDraft状態の最初の切り替えイベント
+	TimelineItems struct {
+		Nodes []struct {
+			Typename string `json:"__typename"`
+		} `json:"nodes"`
+	} `json:"timelineItems"`
+	Reviews struct {
+		Nodes []struct {
+			State       string `json:"state"`
+			SubmittedAt string `json:"submittedAt"`
+		} `json:"nodes"`
+	} `json:"reviews"`
+}
+
+// searchRequest は1回の検索に必要なGraphQLクエリと変数
+type searchRequest struct {
+	graphQL   string
+	variables map[string]string
+	org       string
+	query     string
+}
+
+// SearchPRs はPRを検索する。
+// 検索APIは1クエリあたり最大1000件しか返さないため、件数が上限を超える場合は
+// 日付範囲を再帰的に二分割して取得し、URLで重複を除いて結合する。
+// それでも取得しきれない範囲があった場合は、取得できた結果とともに
+// apperrors.ErrSearchTruncated をラップしたエラーを返す
 func (c *Client) SearchPRs(org string, query string, dateFilter string) ([]PullRequest, error) {
-	q := fmt.Sprintf("%s org:%s %s", query, org, dateFilter)
+	return c.search(searchRequest{
+		graphQL: searchQuery,
+		org:     org,
+		query:   query,
+	}, dateFilter)
+}
+
+// SearchReviewedPRs は reviewer が（自分以外のPRに対して）レビューしたPRを検索する。
+// 各PRには reviewer 自身が提出したレビューの状態と提出日時が含まれる
+func (c *Client) SearchReviewedPRs(org string, reviewer string, dateFilter string) ([]PullRequest, error) {
+	return c.search(searchRequest{
+		graphQL:   reviewSearchQuery,
+		variables: map[string]string{"reviewer": reviewer},
+		org:       org,
+		query:     "is:pr reviewed-by:" + reviewer + " -author:" + reviewer,
+	}, dateFilter)
+}
 
+func (c *Client) search(req searchRequest, dateFilter string) ([]PullRequest, error) {
+	var truncated []string
+	prs, err := c.searchSplit(req, dateFilter, &truncated)
+	if err != nil {
+		return nil, err
+	}
+	prs = dedupeByURL(prs)
+
+	if len(truncated) > 0 {
+		return prs, fmt.Errorf("%w: %s", apperrors.ErrSearchTruncated, strings.Join(truncated, ", "))
+	}
+	return prs, nil
+}
+
+// searchSplit は件数が上限を超える場合に日付範囲を二分割しながら検索する
+func (c *Client) searchSplit(req searchRequest, dateFilter string, truncated *[]string) ([]PullRequest, error) {
+	left, right, canSplit := splitDateFilter(dateFilter)
+
+	q := fmt.Sprintf("%s org:%s %s", req.query, req.org, dateFilter)
+	prs, total, err := c.searchPages(req, q, canSplit)
+	if err != nil {
+		return nil, err
+	}
+
+	if total <= searchResultLimit {
+		return prs, nil
+	}
+	if !canSplit {
+		*truncated = append(*truncated, fmt.Sprintf("%s (%d/%d)", q, len(prs), total))
+		return prs, nil
+	}
+
+	leftPRs, err := c.searchSplit(req, left, truncated)
+	if err != nil {
+		return nil, err
+	}
+	rightPRs, err := c.searchSplit(req, right, truncated)
+	if err != nil {
+		return nil, err
+	}
+	return append(leftPRs, rightPRs...), nil
+}
+
+// searchPages は検索結果を全ページ取得し、結果と総件数を返す。
+// stopOverLimit が true の場合、総件数が上限を超えていれば1ページ目で取得を打ち切る
+func (c *Client) searchPages(req searchRequest, q string, stopOverLimit bool) ([]PullRequest, int, error) {
 	var allPRs []PullRequest
 	var cursor string
+	var total int
 
 	for {
-		args := []string{"api", "graphql",
-			"-f", "q=" + q,
-			"-f", "query=" + searchQuery,
+		variables := map[string]string{
+			"q":      q,
+			"cursor": cursor,
 		}
-		if cursor != "" {
-			args = append(args, "-f", "cursor="+cursor)
+		for k, v := range req.variables {
+			variables[k] = v
 		}
 
-		out, err := c.executor.Execute("gh", args...)
+		out, err := c.transport.GraphQL(req.graphQL, variables)
 		if err != nil {
-			return nil, fmt.Errorf("gh api graphql failed: %w", err)
+			return nil, 0, err
 		}
 
 		var resp graphQLResponse
 		if err := json.Unmarshal(out, &resp); err != nil {
-			return nil, fmt.Errorf("failed to parse response: %w", err)
+			return nil, 0, fmt.Errorf("failed to parse response: %w", err)
+		}
+
+		total = resp.Data.Search.IssueCount
+		if stopOverLimit && total > searchResultLimit {
+			return nil, total, nil
 		}
 
 		for _, node := range resp.Data.Search.Nodes {
 			if node.URL == "" {
 				continue
 			}
-
-			pr := PullRequest{
-				Title:        node.Title,
-				URL:          node.URL,
-				Repository:   node.Repository.Name,
-				State:        normalizeState(node.State),
-				IsDraft:      node.IsDraft,
-				Additions:    node.Additions,
-				Deletions:    node.Deletions,
-				ChangedFiles: node.ChangedFiles,
-				Comments:     node.Comments.TotalCount,
-			}
-
-			if t, err := time.Parse(time.RFC3339, node.CreatedAt); err == nil {
-				pr.CreatedAt = t
-			}
-			if t, err := time.Parse(time.RFC3339, node.UpdatedAt); err == nil {
-				pr.UpdatedAt = t
-			}
-			if node.MergedAt != "" {
-				if t, err := time.Parse(time.RFC3339, node.MergedAt); err == nil {
-					pr.MergedAt = &t
-				}
-			}
-
-			allPRs = append(allPRs, pr)
+			allPRs = append(allPRs, node.toPullRequest())
 		}
 
 		if !resp.Data.Search.PageInfo.HasNextPage {
@@ -203,7 +390,113 @@ func (c *Client) SearchPRs(org string, query string, dateFilter string) ([]PullR
 		cursor = resp.Data.Search.PageInfo.EndCursor
 	}
 
-	return allPRs, nil
+	return allPRs, total, nil
+}
+
+func (node prNode) toPullRequest() PullRequest {
+	pr := PullRequest{
+		Title:        node.Title,
+		URL:          node.URL,
+		Repository:   node.Repository.NameWithOwner,
+		State:        normalizeState(node.State),
+		IsDraft:      node.IsDraft,
+		Additions:    node.Additions,
+		Deletions:    node.Deletions,
+		ChangedFiles: node.ChangedFiles,
+		Comments:     node.Comments.TotalCount,
+	}
+
+	pr.CreatedAsDraft = node.createdAsDraft()
+	for _, l := range node.Labels.Nodes {
+		pr.Labels = append(pr.Labels, l.Name)
+	}
+
+	if t, err := time.Parse(time.RFC3339, node.CreatedAt); err == nil {
+		pr.CreatedAt = t
+	}
+	if t, err := time.Parse(time.RFC3339, node.UpdatedAt); err == nil {
+		pr.UpdatedAt = t
+	}
+	if node.MergedAt != "" {
+		if t, err := time.Parse(time.RFC3339, node.MergedAt); err == nil {
+			pr.MergedAt = &t
+		}
+	}
+	if node.ClosedAt != "" {
+		if t, err := time.Parse(time.RFC3339, node.ClosedAt); err == nil {
+			pr.ClosedAt = &t
+		}
+	}
+	for _, r := range node.Reviews.Nodes {
+		// 未提出（PENDING）のレビューは submittedAt を持たない
+		t, err := time.Parse(time.RFC3339, r.SubmittedAt)
+		if err != nil {
+			continue
+		}
+		pr.Reviews = append(pr.Reviews, Review{
+			State:       normalizeReviewState(r.State),
+			SubmittedAt: t,
+		})
+	}
+	return pr
+}
+
+// createdAsDraft は作成時点でDraftだったかを判定する。
+// 最初の切り替えイベントが ReadyForReview ならDraftで作成され、ConvertToDraft ならDraftではなかった。
+// イベントがなければ現在の状態のまま変わっていない
+func (node prNode) createdAsDraft() bool {
+	if len(node.TimelineItems.Nodes) == 0 {
+		return node.IsDraft
+	}
+	return node.TimelineItems.Nodes[0].Typename == "ReadyForReviewEvent"
+}
+
+// splitDateFilter は "field:start..end" 形式の日付フィルタを中間点で二分割する。
+// 分割できない（形式が異なる、または範囲が1秒以下）場合は ok=false を返す
+func splitDateFilter(dateFilter string) (left, right string, ok bool) {
+	field, dateRange, found := strings.Cut(dateFilter, ":")
+	if !found {
+		return "", "", false
+	}
+	startStr, endStr, found := strings.Cut(dateRange, "..")
+	if !found {
+		return "", "", false
+	}
+	start, err := time.Parse(time.RFC3339, startStr)
+	if err != nil {
+		return "", "", false
+	}
+	end, err := time.Parse(time.RFC3339, endStr)
+	if err != nil {
+		return "", "", false
+	}
+	if end.Sub(start) <= time.Second {
+		return "", "", false
+	}
+
+	mid := start.Add(end.Sub(start) / 2).Truncate(time.Second)
+	left = field + ":" + start.Format(time.RFC3339) + ".." + mid.Format(time.RFC3339)
+	right = field + ":" + mid.Add(time.Second).Format(time.RFC3339) + ".." + end.Format(time.RFC3339)
+	return left, right, true
+}
+
+// dedupeByURL はURLが重複するPRを取り除く（先に現れたものを残す）
+func dedupeByURL(prs []PullRequest) []PullRequest {
+	seen := make(map[string]bool, len(prs))
+	result := make([]PullRequest, 0, len(prs))
+	for _, p := range prs {
+		if seen[p.URL] {
+			continue
+		}
+		seen[p.URL] = true
+		result = append(result, p)
+	}
+	return result
+}
+
+// normalizeReviewState はレビュー状態を小文字に正規化する (例: CHANGES_REQUESTED → changes_requested)
+func normalizeReviewState(state string) string {
+	return strings.ToLower(state)
 }
 
 func normalizeState(state string) string {
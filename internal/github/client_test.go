@@ -1,6 +1,7 @@
 package github
 
 import (
+	"encoding/json"
 	"errors"
 	"strings"
 	"testing"
@@ -28,6 +29,33 @@ func TestNormalizeState(t *testing.T) {
 	}
 }
 
+func TestPRNode_CreatedAsDraft(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    string
+		isDraft bool
+		want    bool
+	}{
+		{"no events, open", `{"nodes":[]}`, false, false},
+		{"no events, still draft", `{"nodes":[]}`, true, true},
+		{"ready for review later", `{"nodes":[{"__typename":"ReadyForReviewEvent"}]}`, false, true},
+		{"converted to draft later", `{"nodes":[{"__typename":"ConvertToDraftEvent"}]}`, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var node prNode
+			node.IsDraft = tt.isDraft
+			if err := json.Unmarshal([]byte(tt.json), &node.TimelineItems); err != nil {
+				t.Fatalf("unmarshal failed: %v", err)
+			}
+			if got := node.toPullRequest().CreatedAsDraft; got != tt.want {
+				t.Errorf("CreatedAsDraft: got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestClient_Username(t *testing.T) {
 	c := &Client{username: "testuser"}
 	if got := c.Username(); got != "testuser" {
@@ -135,7 +163,8 @@ func TestClient_SearchPRs(t *testing.T) {
 						"deletions": 50,
 						"changedFiles": 5,
 						"comments": {"totalCount": 3},
-						"repository": {"name": "test-repo"}
+						"repository": {"nameWithOwner": "test-org/test-repo"},
+						"labels": {"nodes": [{"name": "type/bug"}, {"name": "area/api"}]}
 					}
 				]
 			}
@@ -145,7 +174,7 @@ func TestClient_SearchPRs(t *testing.T) {
 	mock := NewMockExecutor()
 	mock.SetResponse("graphql", []byte(graphQLResponse))
 
-	client := &Client{username: "testuser", executor: mock}
+	client := &Client{username: "testuser", transport: &ghTransport{executor: mock}}
 	prs, err := client.SearchPRs("test-org", "is:pr", "created:2025-01-01..2025-01-31")
 	if err != nil {
 		t.Fatalf("SearchPRs() failed: %v", err)
@@ -159,8 +188,8 @@ func TestClient_SearchPRs(t *testing.T) {
 	if pr.Title != "Test PR" {
 		t.Errorf("Title: got %q, want %q", pr.Title, "Test PR")
 	}
-	if pr.Repository != "test-repo" {
-		t.Errorf("Repository: got %q, want %q", pr.Repository, "test-repo")
+	if pr.Repository != "test-org/test-repo" {
+		t.Errorf("Repository: got %q, want %q", pr.Repository, "test-org/test-repo")
 	}
 	if pr.State != "open" {
 		t.Errorf("State: got %q, want %q", pr.State, "open")
@@ -174,6 +203,9 @@ func TestClient_SearchPRs(t *testing.T) {
 	if pr.Comments != 3 {
 		t.Errorf("Comments: got %d, want %d", pr.Comments, 3)
 	}
+	if strings.Join(pr.Labels, ",") != "type/bug,area/api" {
+		t.Errorf("Labels: got %v, want [type/bug area/api]", pr.Labels)
+	}
 }
 
 func TestClient_SearchPRs_Pagination(t *testing.T) {
@@ -196,7 +228,7 @@ func TestClient_SearchPRs_Pagination(t *testing.T) {
 						"deletions": 5,
 						"changedFiles": 1,
 						"comments": {"totalCount": 1},
-						"repository": {"name": "test-repo"}
+						"repository": {"nameWithOwner": "test-org/test-repo"}
 					}
 				]
 			}
@@ -222,7 +254,7 @@ func TestClient_SearchPRs_Pagination(t *testing.T) {
 						"deletions": 10,
 						"changedFiles": 2,
 						"comments": {"totalCount": 2},
-						"repository": {"name": "test-repo"}
+						"repository": {"nameWithOwner": "test-org/test-repo"}
 					}
 				]
 			}
@@ -235,7 +267,7 @@ func TestClient_SearchPRs_Pagination(t *testing.T) {
 		callCount: &callCount,
 	}
 
-	client := &Client{username: "testuser", executor: mock}
+	client := &Client{username: "testuser", transport: &ghTransport{executor: mock}}
 	prs, err := client.SearchPRs("test-org", "is:pr", "created:2025-01-01..2025-01-31")
 	if err != nil {
 		t.Fatalf("SearchPRs() failed: %v", err)
@@ -266,3 +298,23 @@ func (m *PaginationMockExecutor) Execute(name string, args ...string) ([]byte, e
 	*m.callCount++
 	return m.responses[idx], nil
 }
+
+func TestPullRequest_Owner(t *testing.T) {
+	tests := []struct {
+		repository string
+		want       string
+	}{
+		{"my-org/repo", "my-org"},
+		{"repo", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.repository, func(t *testing.T) {
+			p := PullRequest{Repository: tt.repository}
+			if got := p.Owner(); got != tt.want {
+				t.Errorf("Owner(): got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package github
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	apperrors "github.com/taikicoco/shiraberu/internal/errors"
+)
+
+// fakeSearchTransport は検索APIの件数上限を再現するTransport実装
+type fakeSearchTransport struct {
+	createdAt []time.Time
+	calls     int
+}
+
+func (f *fakeSearchTransport) Login() (string, error) {
+	return "testuser", nil
+}
+
+func (f *fakeSearchTransport) GraphQL(_ string, variables map[string]string) ([]byte, error) {
+	f.calls++
+
+	q := variables["q"]
+	idx := strings.Index(q, "created:")
+	startStr, endStr, _ := strings.Cut(q[idx+len("created:"):], "..")
+	start, _ := time.Parse(time.RFC3339, startStr)
+	end, _ := time.Parse(time.RFC3339, endStr)
+
+	var matched []int
+	for i, t := range f.createdAt {
+		if !t.Before(start) && !t.After(end) {
+			matched = append(matched, i)
+		}
+	}
+
+	offset := 0
+	if variables["cursor"] != "" {
+		offset, _ = strconv.Atoi(variables["cursor"])
+	}
+	// 検索APIは先頭1000件までしか返さない
+	limit := min(len(matched), searchResultLimit)
+	pageEnd := min(offset+100, limit)
+
+	var resp graphQLResponse
+	resp.Data.Search.IssueCount = len(matched)
+	resp.Data.Search.PageInfo.HasNextPage = pageEnd < limit
+	resp.Data.Search.PageInfo.EndCursor = strconv.Itoa(pageEnd)
+	for _, i := range matched[offset:pageEnd] {
+		resp.Data.Search.Nodes = append(resp.Data.Search.Nodes, prNode{
+			URL:       fmt.Sprintf("https://github.com/test/repo/pull/%d", i),
+			CreatedAt: f.createdAt[i].Format(time.RFC3339),
+		})
+	}
+	return json.Marshal(resp)
+}
+
+func TestClient_SearchPRs_SplitsOverLimit(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	fake := &fakeSearchTransport{}
+	for i := 0; i < 2500; i++ {
+		fake.createdAt = append(fake.createdAt, base.Add(time.Duration(i)*17*time.Minute))
+	}
+
+	client := &Client{username: "testuser", transport: fake}
+	prs, err := client.SearchPRs("test-org", "is:pr", "created:2025-01-01T00:00:00Z..2025-02-28T23:59:59Z")
+	if err != nil {
+		t.Fatalf("SearchPRs() failed: %v", err)
+	}
+	if len(prs) != 2500 {
+		t.Errorf("len(prs): got %d, want 2500", len(prs))
+	}
+}
+
+func TestClient_SearchPRs_Truncated(t *testing.T) {
+	// 同一時刻に1000件超 → これ以上分割できない
+	at := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	fake := &fakeSearchTransport{}
+	for i := 0; i < 1200; i++ {
+		fake.createdAt = append(fake.createdAt, at)
+	}
+
+	client := &Client{username: "testuser", transport: fake}
+	prs, err := client.SearchPRs("test-org", "is:pr", "created:2025-01-10T12:00:00Z..2025-01-10T12:00:00Z")
+	if !errors.Is(err, apperrors.ErrSearchTruncated) {
+		t.Fatalf("error: got %v, want ErrSearchTruncated", err)
+	}
+	if len(prs) != searchResultLimit {
+		t.Errorf("len(prs): got %d, want %d (partial results)", len(prs), searchResultLimit)
+	}
+	if !strings.Contains(err.Error(), "1000/1200") {
+		t.Errorf("error should describe fetched/total, got: %v", err)
+	}
+}
+
+func TestSplitDateFilter(t *testing.T) {
+	tests := []struct {
+		name      string
+		filter    string
+		wantLeft  string
+		wantRight string
+		wantOK    bool
+	}{
+		{
+			name:      "splits range at midpoint",
+			filter:    "merged:2025-01-01T00:00:00+09:00..2025-01-02T23:59:59+09:00",
+			wantLeft:  "merged:2025-01-01T00:00:00+09:00..2025-01-01T23:59:59+09:00",
+			wantRight: "merged:2025-01-02T00:00:00+09:00..2025-01-02T23:59:59+09:00",
+			wantOK:    true,
+		},
+		{
+			name:   "single instant cannot be split",
+			filter: "created:2025-01-01T00:00:00Z..2025-01-01T00:00:00Z",
+			wantOK: false,
+		},
+		{
+			name:   "date-only range is not supported",
+			filter: "updated:2025-01-01..2025-01-31",
+			wantOK: false,
+		},
+		{
+			name:   "no field",
+			filter: "2025-01-01T00:00:00Z",
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			left, right, ok := splitDateFilter(tt.filter)
+			if ok != tt.wantOK {
+				t.Fatalf("ok: got %v, want %v", ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if left != tt.wantLeft {
+				t.Errorf("left: got %q, want %q", left, tt.wantLeft)
+			}
+			if right != tt.wantRight {
+				t.Errorf("right: got %q, want %q", right, tt.wantRight)
+			}
+		})
+	}
+}
+
+func TestDedupeByURL(t *testing.T) {
+	prs := []PullRequest{
+		{Title: "A", URL: "https://github.com/test/repo/pull/1"},
+		{Title: "B", URL: "https://github.com/test/repo/pull/2"},
+		{Title: "A dup", URL: "https://github.com/test/repo/pull/1"},
+	}
+
+	got := dedupeByURL(prs)
+	if len(got) != 2 {
+		t.Fatalf("len: got %d, want 2", len(got))
+	}
+	if got[0].Title != "A" {
+		t.Errorf("first occurrence should be kept, got %q", got[0].Title)
+	}
+}
+
+func TestClient_SearchReviewedPRs(t *testing.T) {
+	var gotVars map[string]string
+	var gotQuery string
+	tr := &fakeTransport{graphQL: func(query string, variables map[string]string) ([]byte, error) {
+		gotQuery = query
+		gotVars = variables
+		return []byte(`{"data":{"search":{"issueCount":1,"pageInfo":{"hasNextPage":false},"nodes":[
+			{"title":"Reviewed","url":"https://github.com/test/repo/pull/1","state":"OPEN","repository":{"nameWithOwner":"test/repo"},
+			 "reviews":{"nodes":[
+				{"state":"CHANGES_REQUESTED","submittedAt":"2025-01-10T01:00:00Z"},
+				{"state":"APPROVED","submittedAt":"2025-01-11T01:00:00Z"},
+				{"state":"PENDING","submittedAt":null}
+			 ]}}
+		]}}}`), nil
+	}}
+
+	client := &Client{username: "me", transport: tr}
+	prs, err := client.SearchReviewedPRs("test-org", "reviewer1", "updated:2025-01-01T00:00:00Z..2025-01-31T23:59:59Z")
+	if err != nil {
+		t.Fatalf("SearchReviewedPRs() failed: %v", err)
+	}
+
+	if gotVars["reviewer"] != "reviewer1" {
+		t.Errorf("reviewer variable: got %q, want %q", gotVars["reviewer"], "reviewer1")
+	}
+	if !strings.Contains(gotVars["q"], "reviewed-by:reviewer1 -author:reviewer1") {
+		t.Errorf("q: got %q, want reviewed-by filter", gotVars["q"])
+	}
+	if !strings.Contains(gotQuery, "reviews(first: 100, author: $reviewer)") {
+		t.Error("query should request the reviewer's reviews")
+	}
+
+	if len(prs) != 1 {
+		t.Fatalf("len(prs): got %d, want 1", len(prs))
+	}
+	reviews := prs[0].Reviews
+	if len(reviews) != 2 {
+		t.Fatalf("len(Reviews): got %d, want 2 (pending review skipped)", len(reviews))
+	}
+	if reviews[0].State != ReviewChangesRequested {
+		t.Errorf("Reviews[0].State: got %q, want %q", reviews[0].State, ReviewChangesRequested)
+	}
+	want := time.Date(2025, 1, 11, 1, 0, 0, 0, time.UTC)
+	if !reviews[1].SubmittedAt.Equal(want) {
+		t.Errorf("Reviews[1].SubmittedAt: got %v, want %v", reviews[1].SubmittedAt, want)
+	}
+}
+
+// fakeTransport は関数でGraphQLの挙動を差し替えられるTransport実装
+type fakeTransport struct {
+	graphQL func(query string, variables map[string]string) ([]byte, error)
+}
+
+func (f *fakeTransport) Login() (string, error) {
+	return "testuser", nil
+}
+
+func (f *fakeTransport) GraphQL(query string, variables map[string]string) ([]byte, error) {
+	return f.graphQL(query, variables)
+}
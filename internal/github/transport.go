@@ -0,0 +1,192 @@
+package github
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	apperrors "github.com/taikicoco/shiraberu/internal/errors"
+)
+
+const (
+	// DefaultHost はgithub.comのホスト名
+	DefaultHost = "github.com"
+	// DefaultBaseURL はgithub.comのAPIベースURL
+	DefaultBaseURL = "https://api.github.com"
+
+	httpTimeout = 30 * time.Second
+)
+
+// Transport はGitHub APIへの通信手段を抽象化するインターフェース
+type Transport interface {
+	// Login は認証済みユーザーのログイン名を返す
+	Login() (string, error)
+	// GraphQL はGraphQLクエリを実行し、レスポンスボディをそのまま返す
+	GraphQL(query string, variables map[string]string) ([]byte, error)
+}
+
+// ghTransport は gh CLI 経由でAPIを呼び出すTransport実装
+type ghTransport struct {
+	executor CommandExecutor
+	host     string
+}
+
+// apiArgs は gh api の共通引数を返す
+func (t *ghTransport) apiArgs(endpoint string) []string {
+	args := []string{"api", endpoint}
+	if t.host != "" {
+		args = append(args, "--hostname", t.host)
+	}
+	return args
+}
+
+func (t *ghTransport) Login() (string, error) {
+	args := append(t.apiArgs("user"), "--jq", ".login")
+	out, err := t.executor.Execute("gh", args...)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+func (t *ghTransport) GraphQL(query string, variables map[string]string) ([]byte, error) {
+	args := t.apiArgs("graphql")
+	for _, k := range sortedKeys(variables) {
+		if variables[k] == "" {
+			continue
+		}
+		args = append(args, "-f", k+"="+variables[k])
+	}
+	args = append(args, "-f", "query="+query)
+
+	out, err := t.executor.Execute("gh", args...)
+	if err != nil {
+		return nil, fmt.Errorf("gh api graphql failed: %w", err)
+	}
+	return out, nil
+}
+
+// HTTPTransport は net/http で直接GraphQL APIを呼び出すTransport実装
+type HTTPTransport struct {
+	baseURL    string
+	token      string
+	httpClient *http.Client
+}
+
+// NewHTTPTransport は新しいHTTPTransportを作成する。baseURLが空の場合はgithub.comを使用する
+func NewHTTPTransport(baseURL, token string) *HTTPTransport {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	return &HTTPTransport{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		token:      token,
+		httpClient: &http.Client{Timeout: httpTimeout},
+	}
+}
+
+const viewerQuery = `query { viewer { login } }`
+
+func (t *HTTPTransport) Login() (string, error) {
+	out, err := t.GraphQL(viewerQuery, nil)
+	if err != nil {
+		return "", err
+	}
+
+	var resp struct {
+		Data struct {
+			Viewer struct {
+				Login string `json:"login"`
+			} `json:"viewer"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(out, &resp); err != nil {
+		return "", fmt.Errorf("failed to parse response: %w", err)
+	}
+	return resp.Data.Viewer.Login, nil
+}
+
+func (t *HTTPTransport) GraphQL(query string, variables map[string]string) ([]byte, error) {
+	vars := make(map[string]string, len(variables))
+	for k, v := range variables {
+		if v != "" {
+			vars[k] = v
+		}
+	}
+	body, err := json.Marshal(map[string]any{
+		"query":     query,
+		"variables": vars,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, t.baseURL+"/graphql", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "bearer "+t.token)
+
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", apperrors.ErrAPIFailed, err)
+	}
+	defer resp.Body.Close()
+
+	out, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: %s\n%s", apperrors.ErrAPIFailed, resp.Status, strings.TrimSpace(string(out)))
+	}
+
+	// GraphQLはエラー時もHTTP 200を返すため、errorsフィールドを確認する
+	var errResp struct {
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(out, &errResp); err == nil && len(errResp.Errors) > 0 {
+		msgs := make([]string, 0, len(errResp.Errors))
+		for _, e := range errResp.Errors {
+			msgs = append(msgs, e.Message)
+		}
+		return nil, fmt.Errorf("%w: %s", apperrors.ErrAPIFailed, strings.Join(msgs, "; "))
+	}
+
+	return out, nil
+}
+
+// apiBaseURL はホスト名からAPIベースURLを求める。
+// GitHub Enterprise Server では https://<host>/api 配下にAPIが提供される
+func apiBaseURL(host string) string {
+	if host == "" || host == DefaultHost {
+		return DefaultBaseURL
+	}
+	return "https://" + host + "/api"
+}
+
+// tokenFromEnv は GH_TOKEN または GITHUB_TOKEN からトークンを取得する
+func tokenFromEnv() string {
+	if token := os.Getenv("GH_TOKEN"); token != "" {
+		return token
+	}
+	return os.Getenv("GITHUB_TOKEN")
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,253 @@
+package github
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apperrors "github.com/taikicoco/shiraberu/internal/errors"
+)
+
+// newGraphQLServer はGraphQL APIのスタブサーバーを作成する
+func newGraphQLServer(t *testing.T, handler func(query string, variables map[string]string) string) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/graphql" {
+			http.NotFound(w, r)
+			return
+		}
+		if got := r.Header.Get("Authorization"); got != "bearer test-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message":"Bad credentials"}`))
+			return
+		}
+		var body struct {
+			Query     string            `json:"query"`
+			Variables map[string]string `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(handler(body.Query, body.Variables)))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestNewClient_HTTPTransport(t *testing.T) {
+	srv := newGraphQLServer(t, func(query string, _ map[string]string) string {
+		if strings.Contains(query, "viewer") {
+			return `{"data":{"viewer":{"login":"httpuser"}}}`
+		}
+		return `{"data":{}}`
+	})
+
+	client, err := NewClient(WithToken("test-token"), WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatalf("NewClient() failed: %v", err)
+	}
+	if _, ok := client.transport.(*HTTPTransport); !ok {
+		t.Errorf("transport: got %T, want *HTTPTransport", client.transport)
+	}
+	if client.Username() != "httpuser" {
+		t.Errorf("Username: got %q, want %q", client.Username(), "httpuser")
+	}
+}
+
+func TestNewClient_TokenFromEnv(t *testing.T) {
+	srv := newGraphQLServer(t, func(string, map[string]string) string {
+		return `{"data":{"viewer":{"login":"envuser"}}}`
+	})
+	t.Setenv("GH_TOKEN", "")
+	t.Setenv("GITHUB_TOKEN", "test-token")
+
+	client, err := NewClient(WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatalf("NewClient() failed: %v", err)
+	}
+	if client.Username() != "envuser" {
+		t.Errorf("Username: got %q, want %q", client.Username(), "envuser")
+	}
+}
+
+func TestNewClient_ExecutorTakesPrecedence(t *testing.T) {
+	t.Setenv("GH_TOKEN", "test-token")
+
+	mock := NewMockExecutor()
+	mock.SetResponse("gh api user", []byte("ghuser\n"))
+
+	client, err := NewClient(WithExecutor(mock))
+	if err != nil {
+		t.Fatalf("NewClient() failed: %v", err)
+	}
+	if _, ok := client.transport.(*ghTransport); !ok {
+		t.Errorf("transport: got %T, want *ghTransport", client.transport)
+	}
+}
+
+func TestClient_DefaultTransport_FallbackToGh(t *testing.T) {
+	t.Setenv("GH_TOKEN", "")
+	t.Setenv("GITHUB_TOKEN", "")
+
+	c := &Client{}
+	if _, ok := c.defaultTransport().(*ghTransport); !ok {
+		t.Errorf("defaultTransport(): got %T, want *ghTransport", c.defaultTransport())
+	}
+}
+
+func TestHTTPTransport_SearchPRs_Pagination(t *testing.T) {
+	var cursors []string
+	srv := newGraphQLServer(t, func(_ string, vars map[string]string) string {
+		cursors = append(cursors, vars["cursor"])
+		if vars["cursor"] == "" {
+			return `{"data":{"search":{"pageInfo":{"hasNextPage":true,"endCursor":"c1"},"nodes":[
+				{"title":"PR 1","url":"https://github.com/test/repo/pull/1","state":"OPEN","createdAt":"2025-01-10T10:00:00Z","updatedAt":"2025-01-10T10:00:00Z","repository":{"nameWithOwner":"test/repo"}}
+			]}}}`
+		}
+		return `{"data":{"search":{"pageInfo":{"hasNextPage":false,"endCursor":""},"nodes":[
+			{"title":"PR 2","url":"https://github.com/test/repo/pull/2","state":"MERGED","createdAt":"2025-01-11T10:00:00Z","mergedAt":"2025-01-12T10:00:00Z","updatedAt":"2025-01-12T10:00:00Z","repository":{"nameWithOwner":"test/repo"}}
+		]}}}`
+	})
+
+	client := &Client{username: "testuser", transport: NewHTTPTransport(srv.URL, "test-token")}
+	prs, err := client.SearchPRs("test-org", "is:pr", "created:2025-01-01..2025-01-31")
+	if err != nil {
+		t.Fatalf("SearchPRs() failed: %v", err)
+	}
+
+	if len(prs) != 2 {
+		t.Fatalf("len(prs): got %d, want 2", len(prs))
+	}
+	if prs[1].State != "merged" || prs[1].MergedAt == nil {
+		t.Errorf("prs[1]: got state %q mergedAt %v, want merged with mergedAt", prs[1].State, prs[1].MergedAt)
+	}
+	if len(cursors) != 2 || cursors[0] != "" || cursors[1] != "c1" {
+		t.Errorf("cursors: got %v, want [\"\" \"c1\"]", cursors)
+	}
+}
+
+func TestHTTPTransport_HTTPError(t *testing.T) {
+	srv := newGraphQLServer(t, func(string, map[string]string) string { return `{}` })
+
+	tr := NewHTTPTransport(srv.URL, "wrong-token")
+	_, err := tr.GraphQL(searchQuery, nil)
+	if !errors.Is(err, apperrors.ErrAPIFailed) {
+		t.Fatalf("error: got %v, want ErrAPIFailed", err)
+	}
+	if !strings.Contains(err.Error(), "401") {
+		t.Errorf("error should mention status code, got: %v", err)
+	}
+}
+
+func TestHTTPTransport_GraphQLError(t *testing.T) {
+	srv := newGraphQLServer(t, func(string, map[string]string) string {
+		return `{"errors":[{"message":"Something went wrong"}]}`
+	})
+
+	tr := NewHTTPTransport(srv.URL, "test-token")
+	_, err := tr.GraphQL(searchQuery, nil)
+	if !errors.Is(err, apperrors.ErrAPIFailed) {
+		t.Fatalf("error: got %v, want ErrAPIFailed", err)
+	}
+	if !strings.Contains(err.Error(), "Something went wrong") {
+		t.Errorf("error should contain GraphQL message, got: %v", err)
+	}
+}
+
+func TestNewHTTPTransport_DefaultBaseURL(t *testing.T) {
+	tr := NewHTTPTransport("", "token")
+	if tr.baseURL != DefaultBaseURL {
+		t.Errorf("baseURL: got %q, want %q", tr.baseURL, DefaultBaseURL)
+	}
+
+	tr = NewHTTPTransport("http://localhost:8080/", "token")
+	if tr.baseURL != "http://localhost:8080" {
+		t.Errorf("baseURL: got %q, want trailing slash trimmed", tr.baseURL)
+	}
+}
+
+func TestGhTransport_GraphQLArgs(t *testing.T) {
+	var gotArgs []string
+	tr := &ghTransport{executor: executorFunc(func(name string, args ...string) ([]byte, error) {
+		gotArgs = args
+		return []byte(`{}`), nil
+	})}
+
+	if _, err := tr.GraphQL("query", map[string]string{"q": "is:pr", "cursor": ""}); err != nil {
+		t.Fatalf("GraphQL() failed: %v", err)
+	}
+
+	cmd := strings.Join(gotArgs, " ")
+	if !strings.Contains(cmd, "-f q=is:pr") {
+		t.Errorf("args should contain q variable, got: %s", cmd)
+	}
+	if strings.Contains(cmd, "cursor=") {
+		t.Errorf("empty cursor should be omitted, got: %s", cmd)
+	}
+}
+
+// executorFunc は関数をCommandExecutorとして扱うためのアダプタ
+type executorFunc func(name string, args ...string) ([]byte, error)
+
+func (f executorFunc) Execute(name string, args ...string) ([]byte, error) {
+	return f(name, args...)
+}
+
+func TestGhTransport_Hostname(t *testing.T) {
+	mock := NewMockExecutor()
+	mock.SetResponse("gh api user --hostname ghe.example.com", []byte("gheuser\n"))
+
+	client, err := NewClient(WithExecutor(mock), WithHost("ghe.example.com"))
+	if err != nil {
+		t.Fatalf("NewClient() failed: %v", err)
+	}
+	if client.Username() != "gheuser" {
+		t.Errorf("Username: got %q, want %q", client.Username(), "gheuser")
+	}
+	if client.Host() != "ghe.example.com" {
+		t.Errorf("Host: got %q, want %q", client.Host(), "ghe.example.com")
+	}
+
+	var gotArgs []string
+	tr := &ghTransport{host: "ghe.example.com", executor: executorFunc(func(name string, args ...string) ([]byte, error) {
+		gotArgs = args
+		return []byte(`{}`), nil
+	})}
+	if _, err := tr.GraphQL("query", nil); err != nil {
+		t.Fatalf("GraphQL() failed: %v", err)
+	}
+	if !strings.Contains(strings.Join(gotArgs, " "), "graphql --hostname ghe.example.com") {
+		t.Errorf("graphql args should contain hostname, got: %v", gotArgs)
+	}
+}
+
+func TestClient_Host_Default(t *testing.T) {
+	c := &Client{}
+	if c.Host() != DefaultHost {
+		t.Errorf("Host: got %q, want %q", c.Host(), DefaultHost)
+	}
+}
+
+func TestAPIBaseURL(t *testing.T) {
+	tests := []struct {
+		host string
+		want string
+	}{
+		{"", DefaultBaseURL},
+		{"github.com", DefaultBaseURL},
+		{"ghe.example.com", "https://ghe.example.com/api"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			if got := apiBaseURL(tt.host); got != tt.want {
+				t.Errorf("apiBaseURL(%q): got %q, want %q", tt.host, got, tt.want)
+			}
+		})
+	}
+}
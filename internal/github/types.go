@@ -1,18 +1,51 @@
 package github
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 type PullRequest struct {
-	Title        string
-	URL          string
-	Repository   string
-	State        string
-	IsDraft      bool
-	CreatedAt    time.Time
-	MergedAt     *time.Time
-	UpdatedAt    time.Time
-	Additions    int
-	Deletions    int
-	ChangedFiles int
-	Comments     int
+	Title      string `json:"title"`
+	URL        string `json:"url"`
+	Repository string `json:"repository"` // オーナー付きのリポジトリ名 (例: org/name)
+	State      string `json:"state"`
+	IsDraft    bool   `json:"isDraft"`
+	// CreatedAsDraft は作成時点でDraftだったかどうか
+	CreatedAsDraft bool       `json:"createdAsDraft"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	MergedAt       *time.Time `json:"mergedAt,omitempty"`
+	ClosedAt       *time.Time `json:"closedAt,omitempty"`
+	UpdatedAt      time.Time  `json:"updatedAt"`
+	Additions      int        `json:"additions"`
+	Deletions      int        `json:"deletions"`
+	ChangedFiles   int        `json:"changedFiles"`
+	Comments       int        `json:"comments"`
+	// Labels はPRに付与されたラベル名
+	Labels []string `json:"labels,omitempty"`
+	// Reviews はレビュアー自身が提出したレビュー（SearchReviewedPRs でのみ設定される）
+	Reviews []Review `json:"reviews,omitempty"`
+}
+
+// Owner はリポジトリのオーナー（Organization）名を返す。オーナーを含まない場合は空文字を返す
+func (p PullRequest) Owner() string {
+	owner, _, found := strings.Cut(p.Repository, "/")
+	if !found {
+		return ""
+	}
+	return owner
+}
+
+// Review.State の値
+const (
+	ReviewApproved         = "approved"
+	ReviewChangesRequested = "changes_requested"
+	ReviewCommented        = "commented"
+	ReviewDismissed        = "dismissed"
+)
+
+// Review はPRに対して提出されたレビュー
+type Review struct {
+	State       string    `json:"state"`
+	SubmittedAt time.Time `json:"submittedAt"`
 }
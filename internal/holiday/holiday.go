@@ -0,0 +1,134 @@
+// Package holiday は休日カレンダーと稼働日の判定を扱う
+package holiday
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/taikicoco/shiraberu/internal/period"
+)
+
+const dateLayout = "2006-01-02"
+
+// DefaultWorkdays はデフォルトの稼働曜日（月〜金）
+var DefaultWorkdays = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+
+// Holiday は休日
+type Holiday struct {
+	Date time.Time // 日付（タイムゾーンは意味を持たない）
+	Name string
+}
+
+// Calendar は稼働曜日と休日から稼働日を判定する。
+// nil の *Calendar は月〜金を稼働日とし、休日なしとして扱われる
+type Calendar struct {
+	workdays map[time.Weekday]bool
+	holidays map[string]string // "2006-01-02" → 休日名
+}
+
+// New は稼働曜日と休日から Calendar を作成する。workdays が空の場合は DefaultWorkdays を使用する
+func New(workdays []time.Weekday, holidays []Holiday) *Calendar {
+	if len(workdays) == 0 {
+		workdays = DefaultWorkdays
+	}
+	c := &Calendar{
+		workdays: make(map[time.Weekday]bool, len(workdays)),
+		holidays: make(map[string]string, len(holidays)),
+	}
+	for _, d := range workdays {
+		c.workdays[d] = true
+	}
+	for _, h := range holidays {
+		c.holidays[h.Date.Format(dateLayout)] = h.Name
+	}
+	return c
+}
+
+// HolidayName は t の日付が休日の場合にその名前を返す
+func (c *Calendar) HolidayName(t time.Time) (string, bool) {
+	if c == nil {
+		return "", false
+	}
+	name, ok := c.holidays[t.Format(dateLayout)]
+	return name, ok
+}
+
+// IsBusinessDay は t の日付が稼働日かどうかを返す
+func (c *Calendar) IsBusinessDay(t time.Time) bool {
+	if _, ok := c.HolidayName(t); ok {
+		return false
+	}
+	if c == nil {
+		return t.Weekday() != time.Saturday && t.Weekday() != time.Sunday
+	}
+	return c.workdays[t.Weekday()]
+}
+
+// ParseWorkdays はカンマ区切りの曜日（例: mon,tue,wed または mon-fri）を解釈する
+func ParseWorkdays(s string) ([]time.Weekday, error) {
+	var days []time.Weekday
+	seen := make(map[time.Weekday]bool)
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		from, to, isRange := strings.Cut(part, "-")
+		first, err := period.ParseWeekday(from)
+		if err != nil {
+			return nil, err
+		}
+		last := first
+		if isRange {
+			if last, err = period.ParseWeekday(to); err != nil {
+				return nil, err
+			}
+		}
+		for d := first; ; d = (d + 1) % 7 {
+			if !seen[d] {
+				seen[d] = true
+				days = append(days, d)
+			}
+			if d == last {
+				break
+			}
+		}
+	}
+	if len(days) == 0 {
+		return nil, fmt.Errorf("no workdays in %q", s)
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i] < days[j] })
+	return days, nil
+}
+
+// LoadFiles は休日ファイルを読み込む。拡張子が .ics の場合は iCalendar、.yaml/.yml の場合は YAML として解釈する。
+// iCalendar の日時指定のイベントは loc での日付として扱う
+func LoadFiles(paths []string, loc *time.Location) ([]Holiday, error) {
+	var holidays []Holiday
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+
+		var parsed []Holiday
+		switch ext := strings.ToLower(filepath.Ext(path)); ext {
+		case ".ics":
+			parsed, err = ParseICS(f, loc)
+		case ".yaml", ".yml":
+			parsed, err = ParseYAML(f)
+		default:
+			err = fmt.Errorf("unsupported holiday file type %q (want .ics, .yaml or .yml)", ext)
+		}
+		_ = f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		holidays = append(holidays, parsed...)
+	}
+	return holidays, nil
+}
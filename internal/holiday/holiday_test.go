@@ -0,0 +1,280 @@
+package holiday
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+var jst = time.FixedZone("JST", 9*60*60)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestParseWorkdays(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    []time.Weekday
+		wantErr bool
+	}{
+		{"mon-fri", DefaultWorkdays, false},
+		{"mon,tue,wed,thu,fri", DefaultWorkdays, false},
+		{"sun-thu", []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday}, false},
+		{"fri-mon", []time.Weekday{time.Sunday, time.Monday, time.Friday, time.Saturday}, false},
+		{"Monday, monday", []time.Weekday{time.Monday}, false},
+		{"", nil, true},
+		{"mon-funday", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseWorkdays(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseWorkdays(%q): expected error", tt.input)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseWorkdays(%q) failed: %v", tt.input, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseWorkdays(%q): got %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalendar_IsBusinessDay(t *testing.T) {
+	cal := New([]time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday},
+		[]Holiday{{Date: date(2025, 1, 1), Name: "New Year's Day"}})
+
+	tests := []struct {
+		name string
+		cal  *Calendar
+		date time.Time
+		want bool
+	}{
+		{"nil weekday", nil, date(2025, 1, 6), true},
+		{"nil saturday", nil, date(2025, 1, 4), false},
+		{"holiday", cal, date(2025, 1, 1), false},
+		{"workday sunday", cal, date(2025, 1, 5), true},
+		{"non-working friday", cal, date(2025, 1, 3), false},
+		{"holiday in another timezone", cal, time.Date(2025, 1, 1, 23, 0, 0, 0, time.FixedZone("JST", 9*60*60)), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cal.IsBusinessDay(tt.date); got != tt.want {
+				t.Errorf("IsBusinessDay(%s): got %v, want %v", tt.date.Format("2006-01-02"), got, tt.want)
+			}
+		})
+	}
+
+	if name, ok := cal.HolidayName(date(2025, 1, 1)); !ok || name != "New Year's Day" {
+		t.Errorf("HolidayName: got %q, %v", name, ok)
+	}
+}
+
+func TestParseICS(t *testing.T) {
+	ics := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:20250101",
+		"DTEND;VALUE=DATE:20250102",
+		"SUMMARY:New Year\\, Day",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:20250429",
+		"DTEND;VALUE=DATE:20250501",
+		"SUMMARY:Golden ",
+		" Week",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART:20250721T000000Z",
+		"SUMMARY:Marine Day",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	got, err := ParseICS(strings.NewReader(ics), jst)
+	if err != nil {
+		t.Fatalf("ParseICS failed: %v", err)
+	}
+
+	want := []Holiday{
+		{Date: date(2025, 1, 1), Name: "New Year, Day"},
+		{Date: date(2025, 4, 29), Name: "Golden Week"},
+		{Date: date(2025, 4, 30), Name: "Golden Week"},
+		{Date: date(2025, 7, 21), Name: "Marine Day"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseICS:\n got %v\nwant %v", got, want)
+	}
+}
+
+func TestParseICS_DateTime(t *testing.T) {
+	tests := []struct {
+		name  string
+		props []string
+		want  []time.Time
+	}{
+		{"UTC", []string{"DTSTART:20250720T150000Z"}, []time.Time{date(2025, 7, 21)}},
+		{"TZID", []string{"DTSTART;TZID=America/New_York:20250720T110000"}, []time.Time{date(2025, 7, 21)}},
+		{"quoted TZID", []string{`DTSTART;TZID="Europe/London":20250720T120000`}, []time.Time{date(2025, 7, 20)}},
+		{"floating", []string{"DTSTART:20250720T230000"}, []time.Time{date(2025, 7, 20)}},
+		{"end at midnight", []string{"DTSTART:20250720T150000Z", "DTEND:20250721T150000Z"}, []time.Time{date(2025, 7, 21)}},
+		{"end during the day", []string{"DTSTART:20250720T150000Z", "DTEND:20250721T160000Z"}, []time.Time{date(2025, 7, 21), date(2025, 7, 22)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ics := "BEGIN:VEVENT\n" + strings.Join(tt.props, "\n") + "\nSUMMARY:Marine Day\nEND:VEVENT\n"
+			got, err := ParseICS(strings.NewReader(ics), jst)
+			if err != nil {
+				t.Fatalf("ParseICS failed: %v", err)
+			}
+			var dates []time.Time
+			for _, h := range got {
+				dates = append(dates, h.Date)
+			}
+			if !reflect.DeepEqual(dates, tt.want) {
+				t.Errorf("got %v, want %v", dates, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseICS_Yearly(t *testing.T) {
+	tests := []struct {
+		name  string
+		props []string
+		want  []time.Time
+	}{
+		{"count", []string{"DTSTART;VALUE=DATE:20230101", "RRULE:FREQ=YEARLY;COUNT=3"}, []time.Time{date(2023, 1, 1), date(2024, 1, 1), date(2025, 1, 1)}},
+		{"until", []string{"DTSTART;VALUE=DATE:20230101", "RRULE:FREQ=YEARLY;UNTIL=20250101"}, []time.Time{date(2023, 1, 1), date(2024, 1, 1), date(2025, 1, 1)}},
+		{"interval", []string{"DTSTART;VALUE=DATE:20230101", "RRULE:FREQ=YEARLY;INTERVAL=2;COUNT=2"}, []time.Time{date(2023, 1, 1), date(2025, 1, 1)}},
+		{"by month and day", []string{"DTSTART;VALUE=DATE:20231225", "RRULE:FREQ=YEARLY;BYMONTH=12;BYMONTHDAY=25;COUNT=2"}, []time.Time{date(2023, 12, 25), date(2024, 12, 25)}},
+		{"leap day", []string{"DTSTART;VALUE=DATE:20200229", "RRULE:FREQ=YEARLY;COUNT=2"}, []time.Time{date(2020, 2, 29), date(2024, 2, 29)}},
+		{"multiple days", []string{"DTSTART;VALUE=DATE:20231231", "DTEND;VALUE=DATE:20240102", "RRULE:FREQ=YEARLY;COUNT=2"}, []time.Time{date(2023, 12, 31), date(2024, 1, 1), date(2024, 12, 31), date(2025, 1, 1)}},
+		{"exdate and rdate", []string{"DTSTART;VALUE=DATE:20230101", "RRULE:FREQ=YEARLY;COUNT=3", "EXDATE;VALUE=DATE:20240101", "RDATE;VALUE=DATE:20240102"}, []time.Time{date(2023, 1, 1), date(2025, 1, 1), date(2024, 1, 2)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ics := "BEGIN:VEVENT\n" + strings.Join(tt.props, "\n") + "\nSUMMARY:Holiday\nEND:VEVENT\n"
+			got, err := ParseICS(strings.NewReader(ics), jst)
+			if err != nil {
+				t.Fatalf("ParseICS failed: %v", err)
+			}
+			var dates []time.Time
+			for _, h := range got {
+				dates = append(dates, h.Date)
+			}
+			if !reflect.DeepEqual(dates, tt.want) {
+				t.Errorf("got %v, want %v", dates, tt.want)
+			}
+		})
+	}
+
+	// COUNT と UNTIL がない場合も決まった回数まで展開する
+	got, err := ParseICS(strings.NewReader("BEGIN:VEVENT\nDTSTART;VALUE=DATE:20000101\nRRULE:FREQ=YEARLY\nEND:VEVENT\n"), jst)
+	if err != nil {
+		t.Fatalf("ParseICS failed: %v", err)
+	}
+	if len(got) != maxYearlyOccurrences || !got[len(got)-1].Date.Equal(date(2099, 1, 1)) {
+		t.Errorf("unbounded RRULE: got %d holidays", len(got))
+	}
+}
+
+func TestParseICS_Invalid(t *testing.T) {
+	inputs := []string{
+		"BEGIN:VEVENT\nSUMMARY:No date\nEND:VEVENT\n",
+		"BEGIN:VEVENT\nDTSTART:2025\nEND:VEVENT\n",
+		"BEGIN:VEVENT\nDTSTART;TZID=Nowhere/City:20250101T000000\nEND:VEVENT\n",
+		"BEGIN:VEVENT\nDTSTART;VALUE=DATE:20250101\nRRULE:FREQ=MONTHLY\nEND:VEVENT\n",
+		"BEGIN:VEVENT\nDTSTART;VALUE=DATE:20250113\nRRULE:FREQ=YEARLY;BYMONTH=1;BYDAY=2MO\nEND:VEVENT\n",
+		"BEGIN:VEVENT\nDTSTART;VALUE=DATE:20250101\nRRULE:FREQ=YEARLY;BYMONTHDAY=2\nEND:VEVENT\n",
+		"BEGIN:VEVENT\nDTSTART;VALUE=DATE:20250101\nRRULE:FREQ=YEARLY;COUNT=0\nEND:VEVENT\n",
+	}
+	for _, input := range inputs {
+		if _, err := ParseICS(strings.NewReader(input), jst); err == nil {
+			t.Errorf("ParseICS(%q): expected error", input)
+		}
+	}
+}
+
+func TestParseICS_ErrorLine(t *testing.T) {
+	ics := strings.Join([]string{
+		"BEGIN:VEVENT",
+		"SUMMARY:Long",
+		" name",
+		" continued",
+		"DTSTART:2025",
+		"END:VEVENT",
+	}, "\r\n")
+
+	_, err := ParseICS(strings.NewReader(ics), jst)
+	if err == nil || !strings.HasPrefix(err.Error(), "line 5: DTSTART:") {
+		t.Errorf("expected an error on line 5, got %v", err)
+	}
+}
+
+func TestParseYAML(t *testing.T) {
+	input := `
+holidays:
+  - date: 2025-01-01
+    name: New Year's Day
+  - date: "2025-12-31"
+    name: Company holiday
+`
+	got, err := ParseYAML(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseYAML failed: %v", err)
+	}
+	want := []Holiday{
+		{Date: date(2025, 1, 1), Name: "New Year's Day"},
+		{Date: date(2025, 12, 31), Name: "Company holiday"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseYAML:\n got %v\nwant %v", got, want)
+	}
+
+	if _, err := ParseYAML(strings.NewReader("holidays:\n  - date: 2025/01/01\n")); err == nil {
+		t.Error("ParseYAML: expected error for invalid date")
+	}
+}
+
+func TestLoadFiles(t *testing.T) {
+	dir := t.TempDir()
+	yamlPath := filepath.Join(dir, "holidays.yml")
+	icsPath := filepath.Join(dir, "holidays.ics")
+	txtPath := filepath.Join(dir, "holidays.txt")
+	files := map[string]string{
+		yamlPath: "holidays:\n  - date: 2025-01-01\n    name: New Year's Day\n",
+		icsPath:  "BEGIN:VEVENT\nDTSTART;VALUE=DATE:20251231\nSUMMARY:Company holiday\nEND:VEVENT\n",
+		txtPath:  "2025-01-01",
+	}
+	for path, content := range files {
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := LoadFiles([]string{yamlPath, icsPath}, jst)
+	if err != nil {
+		t.Fatalf("LoadFiles failed: %v", err)
+	}
+	if len(got) != 2 || got[0].Name != "New Year's Day" || got[1].Name != "Company holiday" {
+		t.Errorf("LoadFiles: got %v", got)
+	}
+
+	if _, err := LoadFiles([]string{txtPath}, jst); err == nil {
+		t.Error("LoadFiles: expected error for unsupported file type")
+	}
+}
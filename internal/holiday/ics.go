@@ -0,0 +1,300 @@
+package holiday
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	icsDateLayout     = "20060102"
+	icsDateTimeLayout = "20060102T150405"
+
+	// maxYearlyOccurrences は COUNT と UNTIL のない RRULE を展開する回数
+	maxYearlyOccurrences = 100
+)
+
+// ParseICS は iCalendar (RFC 5545) の VEVENT を休日として読み込む。
+// 終日イベントの DTEND は含まない日として扱い、複数日のイベントは日ごとに展開する。
+// 日時指定 (DATE-TIME) の値は loc での日付に変換する。
+// 繰り返し (RRULE) は毎年同じ日付の FREQ=YEARLY のみ対応し、それ以外はエラーを返す
+func ParseICS(r io.Reader, loc *time.Location) ([]Holiday, error) {
+	lines, err := unfoldICSLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var holidays []Holiday
+	var event *icsEvent
+
+	for _, line := range lines {
+		name, value, ok := strings.Cut(line.text, ":")
+		if !ok {
+			continue
+		}
+		// プロパティ名のパラメータ (例: DTSTART;VALUE=DATE、DTSTART;TZID=Asia/Tokyo)
+		name, params, _ := strings.Cut(name, ";")
+		name = strings.ToUpper(name)
+
+		switch {
+		case name == "BEGIN" && strings.EqualFold(value, "VEVENT"):
+			event = &icsEvent{exdates: make(map[time.Time]bool)}
+		case name == "END" && strings.EqualFold(value, "VEVENT"):
+			if event == nil {
+				return nil, fmt.Errorf("line %d: END:VEVENT without BEGIN:VEVENT", line.num)
+			}
+			expanded, err := event.expand()
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line.num, err)
+			}
+			holidays = append(holidays, expanded...)
+			event = nil
+		case event == nil:
+			continue
+		default:
+			if err := event.set(name, params, value, loc); err != nil {
+				return nil, fmt.Errorf("line %d: %s: %w", line.num, name, err)
+			}
+		}
+	}
+
+	return holidays, nil
+}
+
+// icsEvent は読み込み中の VEVENT
+type icsEvent struct {
+	start, end time.Time // 日付（end は含まない）
+	summary    string
+	rrule      *icsYearlyRule
+	rdates     []time.Time
+	exdates    map[time.Time]bool
+}
+
+// icsYearlyRule は FREQ=YEARLY の RRULE
+type icsYearlyRule struct {
+	interval   int
+	count      int
+	until      time.Time // 日付（含む）
+	byMonth    int       // 0 の場合は指定なし
+	byMonthDay int       // 0 の場合は指定なし
+}
+
+// set は VEVENT のプロパティを読み込む
+func (e *icsEvent) set(name, params, value string, loc *time.Location) error {
+	switch name {
+	case "DTSTART":
+		date, _, err := parseICSDate(value, params, loc)
+		if err != nil {
+			return err
+		}
+		e.start = date
+	case "DTEND":
+		date, partial, err := parseICSDate(value, params, loc)
+		if err != nil {
+			return err
+		}
+		if partial {
+			// 日の途中で終わる日時指定の DTEND はその日を含む
+			date = date.AddDate(0, 0, 1)
+		}
+		e.end = date
+	case "SUMMARY":
+		e.summary = unescapeICSText(value)
+	case "RRULE":
+		rule, err := parseICSYearlyRule(value, loc)
+		if err != nil {
+			return err
+		}
+		e.rrule = rule
+	case "RDATE", "EXDATE":
+		for _, v := range strings.Split(value, ",") {
+			date, _, err := parseICSDate(v, params, loc)
+			if err != nil {
+				return err
+			}
+			if name == "RDATE" {
+				e.rdates = append(e.rdates, date)
+			} else {
+				e.exdates[date] = true
+			}
+		}
+	}
+	return nil
+}
+
+// expand は VEVENT を繰り返しも含めて日ごとの休日に展開する
+func (e *icsEvent) expand() ([]Holiday, error) {
+	if e.start.IsZero() {
+		return nil, errors.New("VEVENT without DTSTART")
+	}
+	end := e.end
+	if end.IsZero() || !end.After(e.start) {
+		end = e.start.AddDate(0, 0, 1)
+	}
+	days := int(end.Sub(e.start).Hours() / 24)
+
+	starts := []time.Time{e.start}
+	if e.rrule != nil {
+		var err error
+		if starts, err = e.rrule.occurrences(e.start); err != nil {
+			return nil, err
+		}
+	}
+	starts = append(starts, e.rdates...)
+
+	var holidays []Holiday
+	for _, start := range starts {
+		if e.exdates[start] {
+			continue
+		}
+		for i := range days {
+			holidays = append(holidays, Holiday{Date: start.AddDate(0, 0, i), Name: e.summary})
+		}
+	}
+	return holidays, nil
+}
+
+// parseICSYearlyRule は RRULE を解釈する。FREQ=YEARLY と INTERVAL、COUNT、UNTIL、
+// および DTSTART と同じ月日を指す BYMONTH、BYMONTHDAY 以外はエラーを返す
+func parseICSYearlyRule(value string, loc *time.Location) (*icsYearlyRule, error) {
+	rule := &icsYearlyRule{interval: 1}
+	var yearly bool
+	for _, part := range strings.Split(value, ";") {
+		key, v, _ := strings.Cut(part, "=")
+		var err error
+		switch key = strings.ToUpper(key); key {
+		case "FREQ":
+			yearly = strings.EqualFold(v, "YEARLY")
+		case "INTERVAL":
+			rule.interval, err = parseICSPositive(v)
+		case "COUNT":
+			rule.count, err = parseICSPositive(v)
+		case "UNTIL":
+			rule.until, _, err = parseICSDate(v, "", loc)
+		case "BYMONTH":
+			rule.byMonth, err = parseICSPositive(v)
+		case "BYMONTHDAY":
+			rule.byMonthDay, err = parseICSPositive(v)
+		case "WKST":
+			// 週の開始曜日は FREQ=YEARLY の日付に影響しない
+		default:
+			return nil, fmt.Errorf("unsupported rule part %q (only yearly recurrences on the same date are supported)", part)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+	}
+	if !yearly {
+		return nil, fmt.Errorf("unsupported rule %q (only FREQ=YEARLY is supported)", value)
+	}
+	return rule, nil
+}
+
+// occurrences は start から毎年同じ月日の開始日を返す。2月29日は閏年のみ含める
+func (r *icsYearlyRule) occurrences(start time.Time) ([]time.Time, error) {
+	if (r.byMonth != 0 && r.byMonth != int(start.Month())) || (r.byMonthDay != 0 && r.byMonthDay != start.Day()) {
+		return nil, errors.New("RRULE: BYMONTH and BYMONTHDAY must match DTSTART")
+	}
+	limit := r.count
+	if limit == 0 && r.until.IsZero() {
+		limit = maxYearlyOccurrences
+	}
+
+	var starts []time.Time
+	for year := start.Year(); limit == 0 || len(starts) < limit; year += r.interval {
+		date := time.Date(year, start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+		if !r.until.IsZero() && date.After(r.until) {
+			break
+		}
+		if date.Month() != start.Month() {
+			continue
+		}
+		starts = append(starts, date)
+	}
+	return starts, nil
+}
+
+// parseICSPositive は正の整数を解釈する
+func parseICSPositive(s string) (int, error) {
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("invalid number %q", s)
+	}
+	return n, nil
+}
+
+// icsLine は折り返しを戻した行と、その行が始まるファイル上の行番号
+type icsLine struct {
+	num  int
+	text string
+}
+
+// unfoldICSLines は折り返された行（空白またはタブで始まる行）を前の行に連結する
+func unfoldICSLines(r io.Reader) ([]icsLine, error) {
+	var lines []icsLine
+	scanner := bufio.NewScanner(r)
+	for num := 1; scanner.Scan(); num++ {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1].text += line[1:]
+			continue
+		}
+		lines = append(lines, icsLine{num: num, text: line})
+	}
+	return lines, scanner.Err()
+}
+
+// parseICSDate は DATE (20060102) または DATE-TIME (20060102T150405、UTC の場合は末尾に Z) の値を日付に変換する。
+// DATE-TIME は TZID パラメータ（指定がない場合は loc）の日時として解釈し、loc での日付を返す。
+// partial は DATE-TIME が日の途中の時刻かどうか
+func parseICSDate(value, params string, loc *time.Location) (date time.Time, partial bool, err error) {
+	if len(value) == len(icsDateLayout) {
+		date, err = time.Parse(icsDateLayout, value)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("invalid date %q", value)
+		}
+		return date, false, nil
+	}
+
+	var t time.Time
+	switch tzid := icsParam(params, "TZID"); {
+	case strings.HasSuffix(value, "Z"):
+		t, err = time.Parse(icsDateTimeLayout+"Z", value)
+	case tzid != "":
+		tz, lerr := time.LoadLocation(tzid)
+		if lerr != nil {
+			return time.Time{}, false, fmt.Errorf("unknown TZID %q", tzid)
+		}
+		t, err = time.ParseInLocation(icsDateTimeLayout, value, tz)
+	default:
+		// タイムゾーンの指定がない日時 (floating time) はレポートのタイムゾーンの日時として扱う
+		t, err = time.ParseInLocation(icsDateTimeLayout, value, loc)
+	}
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("invalid date %q", value)
+	}
+
+	t = t.In(loc)
+	date = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	partial = t.Hour() != 0 || t.Minute() != 0 || t.Second() != 0
+	return date, partial, nil
+}
+
+// icsParam はプロパティのパラメータ（例: VALUE=DATE;TZID="Asia/Tokyo"）から key の値を返す
+func icsParam(params, key string) string {
+	for _, param := range strings.Split(params, ";") {
+		if k, v, ok := strings.Cut(param, "="); ok && strings.EqualFold(k, key) {
+			return strings.Trim(v, `"`)
+		}
+	}
+	return ""
+}
+
+// unescapeICSText は TEXT 値のエスケープ (\, \; \n \\) を戻す
+func unescapeICSText(s string) string {
+	return strings.NewReplacer(`\\`, `\`, `\,`, ",", `\;`, ";", `\n`, " ", `\N`, " ").Replace(s)
+}
@@ -0,0 +1,39 @@
+package holiday
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// yamlFile は YAML 形式の休日ファイル
+//
+//	holidays:
+//	  - date: 2025-01-01
+//	    name: New Year's Day
+type yamlFile struct {
+	Holidays []struct {
+		Date string `yaml:"date"`
+		Name string `yaml:"name"`
+	} `yaml:"holidays"`
+}
+
+// ParseYAML は YAML 形式の休日ファイルを読み込む
+func ParseYAML(r io.Reader) ([]Holiday, error) {
+	var file yamlFile
+	if err := yaml.NewDecoder(r).Decode(&file); err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	holidays := make([]Holiday, 0, len(file.Holidays))
+	for i, h := range file.Holidays {
+		date, err := time.Parse(dateLayout, h.Date)
+		if err != nil {
+			return nil, fmt.Errorf("holidays[%d]: invalid date %q (want YYYY-MM-DD)", i, h.Date)
+		}
+		holidays = append(holidays, Holiday{Date: date, Name: h.Name})
+	}
+	return holidays, nil
+}
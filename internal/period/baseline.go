@@ -0,0 +1,95 @@
+package period
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	apperrors "github.com/taikicoco/shiraberu/internal/errors"
+)
+
+// Baseline は比較対象の期間の決め方
+type Baseline string
+
+const (
+	// BaselinePrevious は直前の同等期間（CalcPrevious）と比較する
+	BaselinePrevious Baseline = "previous"
+	// BaselineYearAgo は1年前の同じ期間と比較する
+	BaselineYearAgo Baseline = "year-ago"
+	// BaselineCustom は期間式で指定した任意の期間と比較する
+	BaselineCustom Baseline = "custom"
+)
+
+// Comparison は比較対象の期間の指定
+type Comparison struct {
+	Baseline Baseline
+	Expr     string // BaselineCustom の場合の期間式（Parse の形式）
+}
+
+// ParseComparison は比較対象の指定を解釈する。
+// "previous"（空文字も同じ）、"year-ago" 以外は期間式として扱う
+func ParseComparison(s string) Comparison {
+	switch value := strings.TrimSpace(s); strings.ToLower(value) {
+	case "", string(BaselinePrevious):
+		return Comparison{Baseline: BaselinePrevious}
+	case string(BaselineYearAgo):
+		return Comparison{Baseline: BaselineYearAgo}
+	default:
+		return Comparison{Baseline: BaselineCustom, Expr: value}
+	}
+}
+
+// Label は比較対象の表示名を返す
+func (cmp Comparison) Label() string {
+	switch cmp.Baseline {
+	case BaselineYearAgo:
+		return "same period last year"
+	case BaselineCustom:
+		return cmp.Expr
+	default:
+		return "previous period"
+	}
+}
+
+// Range は startDate〜endDate の比較対象となる期間を計算する。
+// 期間式が解釈できない場合は ErrInvalidDate を返す
+func (c *Calendar) Range(cmp Comparison, startDate, endDate time.Time, periodType Type, now time.Time) (time.Time, time.Time, error) {
+	switch cmp.Baseline {
+	case BaselineYearAgo:
+		start, end := YearAgo(startDate, endDate)
+		return start, end, nil
+	case BaselineCustom:
+		start, end, _, err := c.Parse(cmp.Expr, now)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("comparison period: %w", err)
+		}
+		return start, end, nil
+	case BaselinePrevious, "":
+		start, end := c.CalcPrevious(startDate, endDate, periodType)
+		return start, end, nil
+	default:
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: unknown comparison baseline %q", apperrors.ErrInvalidDate, cmp.Baseline)
+	}
+}
+
+// YearAgo は1年前の同じ期間を返す。2/29 は前年の 2/28 として扱う
+func YearAgo(startDate, endDate time.Time) (time.Time, time.Time) {
+	return yearAgo(startDate), yearAgo(endDate)
+}
+
+// addMonthsClamped は n ヶ月後（負の場合は前）の同じ日を返す。
+// その月に同じ日がない場合は月末に切り詰める (例: 3/31 の1ヶ月前 → 2/28)
+func addMonthsClamped(t time.Time, n int) time.Time {
+	first := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location()).AddDate(0, n, 0)
+	lastDay := first.AddDate(0, 1, -1).Day()
+	return time.Date(first.Year(), first.Month(), min(t.Day(), lastDay), 0, 0, 0, 0, t.Location())
+}
+
+func yearAgo(t time.Time) time.Time {
+	y := t.AddDate(-1, 0, 0)
+	if y.Month() != t.Month() {
+		// 2/29 → 3/1 に繰り上がった場合は月末に戻す
+		y = y.AddDate(0, 0, -y.Day())
+	}
+	return y
+}
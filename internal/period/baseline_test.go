@@ -0,0 +1,73 @@
+package period
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	apperrors "github.com/taikicoco/shiraberu/internal/errors"
+)
+
+func TestParseComparison(t *testing.T) {
+	tests := []struct {
+		input string
+		want  Comparison
+		label string
+	}{
+		{"", Comparison{Baseline: BaselinePrevious}, "previous period"},
+		{"previous", Comparison{Baseline: BaselinePrevious}, "previous period"},
+		{"Year-Ago", Comparison{Baseline: BaselineYearAgo}, "same period last year"},
+		{" 2024-Q4 ", Comparison{Baseline: BaselineCustom, Expr: "2024-Q4"}, "2024-Q4"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got := ParseComparison(tt.input)
+			if got != tt.want {
+				t.Errorf("ParseComparison(%q): got %+v, want %+v", tt.input, got, tt.want)
+			}
+			if got.Label() != tt.label {
+				t.Errorf("Label: got %q, want %q", got.Label(), tt.label)
+			}
+		})
+	}
+}
+
+func TestCalendar_Range(t *testing.T) {
+	now := time.Date(2025, 12, 20, 9, 0, 0, 0, time.UTC)
+	date := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name       string
+		cmp        Comparison
+		start, end time.Time
+		periodType Type
+		wantStart  time.Time
+		wantEnd    time.Time
+	}{
+		{"previous month", Comparison{Baseline: BaselinePrevious}, date(2025, 12, 1), date(2025, 12, 20), TypeMonth, date(2025, 11, 1), date(2025, 11, 30)},
+		{"year ago", Comparison{Baseline: BaselineYearAgo}, date(2025, 12, 1), date(2025, 12, 20), TypeMonth, date(2024, 12, 1), date(2024, 12, 20)},
+		{"year ago from leap day", Comparison{Baseline: BaselineYearAgo}, date(2024, 2, 1), date(2024, 2, 29), TypeMonth, date(2023, 2, 1), date(2023, 2, 28)},
+		{"custom", Comparison{Baseline: BaselineCustom, Expr: "2025-Q3"}, date(2025, 12, 1), date(2025, 12, 20), TypeMonth, date(2025, 7, 1), date(2025, 9, 30)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, err := (*Calendar)(nil).Range(tt.cmp, tt.start, tt.end, tt.periodType, now)
+			if err != nil {
+				t.Fatalf("Range failed: %v", err)
+			}
+			if !start.Equal(tt.wantStart) || !end.Equal(tt.wantEnd) {
+				t.Errorf("Range: got %v - %v, want %v - %v", start, end, tt.wantStart, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestCalendar_Range_InvalidCustom(t *testing.T) {
+	now := time.Date(2025, 12, 20, 9, 0, 0, 0, time.UTC)
+	_, _, err := (*Calendar)(nil).Range(Comparison{Baseline: BaselineCustom, Expr: "last-year-ish"}, now, now, TypeCustom, now)
+	if !errors.Is(err, apperrors.ErrInvalidDate) {
+		t.Errorf("error: got %v, want ErrInvalidDate", err)
+	}
+}
@@ -0,0 +1,158 @@
+package period
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	apperrors "github.com/taikicoco/shiraberu/internal/errors"
+)
+
+// ExpressionExamples は Parse が受け付ける期間式の例（ヘルプやエラーメッセージ用）
+const ExpressionExamples = "yesterday, this-week, last-7d, last-2w, 2025-Q3, 2025-05, 2025-W18, 2025-05-01, 2025-05-01..2025-05-15, since 2025-04-01"
+
+var (
+	lastNPattern   = regexp.MustCompile(`^last-(\d+)([dwm])$`)
+	quarterPattern = regexp.MustCompile(`^(\d{4})-q([1-4])$`)
+	weekPattern    = regexp.MustCompile(`^(\d{4})-w(\d{2})$`)
+	monthPattern   = regexp.MustCompile(`^(\d{4})-(\d{2})$`)
+)
+
+// Parse はデフォルトの Calendar で期間式を解釈する
+func Parse(expr string, now time.Time) (start, end time.Time, periodType Type, err error) {
+	return (*Calendar)(nil).Parse(expr, now)
+}
+
+// Parse は期間式を now の日付を基準に解釈し、期間と種類を返す。
+// 受け付ける式:
+//   - プリセット名 (today, yesterday, this-week, last-fiscal-year など)
+//   - last-7d / last-2w / last-3m: 今日を含む直近 N 日 / N 週 / N ヶ月
+//   - 2025-Q3: 会計年度 2025 の第3四半期（1月始まりの場合は暦年の四半期）
+//   - 2025-05: 月
+//   - 2025-W18: ISO 週番号18の月曜日を含む週（週の始まりは Calendar に従う）
+//   - 2025-05-01 / 2025-05-01..2025-05-15: 日付または日付範囲
+//   - since 2025-04-01: 指定日から今日まで
+//
+// 期間の終わりが今日より後の場合は今日までに切り詰める。
+// 解釈できない場合は ErrInvalidDate を返す
+func (c *Calendar) Parse(expr string, now time.Time) (start, end time.Time, periodType Type, err error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	input := strings.ToLower(strings.Join(strings.Fields(expr), " "))
+
+	start, end, periodType, err = c.parse(input, today, now)
+	if err != nil {
+		return time.Time{}, time.Time{}, "", err
+	}
+
+	if start.After(today) {
+		return time.Time{}, time.Time{}, "", fmt.Errorf("%w: %q starts in the future", apperrors.ErrInvalidDate, expr)
+	}
+	if end.Before(start) {
+		return time.Time{}, time.Time{}, "", fmt.Errorf("%w: %q ends before it starts", apperrors.ErrInvalidDate, expr)
+	}
+	if end.After(today) {
+		end = today
+	}
+	return start, end, periodType, nil
+}
+
+func (c *Calendar) parse(input string, today, now time.Time) (time.Time, time.Time, Type, error) {
+	loc := today.Location()
+	invalid := func(format string, args ...any) (time.Time, time.Time, Type, error) {
+		return time.Time{}, time.Time{}, "", fmt.Errorf("%w: "+format, append([]any{apperrors.ErrInvalidDate}, args...)...)
+	}
+
+	if input == "" {
+		return invalid("empty period (try one of: %s)", ExpressionExamples)
+	}
+
+	if start, end, periodType, ok := c.Preset(input, now); ok {
+		return start, end, periodType, nil
+	}
+
+	if rest, ok := strings.CutPrefix(input, "since "); ok {
+		start, err := time.ParseInLocation("2006-01-02", rest, loc)
+		if err != nil {
+			return invalid("%q after \"since\" is not a date (want YYYY-MM-DD)", rest)
+		}
+		return start, today, TypeCustom, nil
+	}
+
+	if m := lastNPattern.FindStringSubmatch(input); m != nil {
+		n, err := strconv.Atoi(m[1])
+		if err != nil || n < 1 {
+			return invalid("%q: the count must be 1 or more", input)
+		}
+		switch m[2] {
+		case "d":
+			return today.AddDate(0, 0, -(n - 1)), today, TypeCustom, nil
+		case "w":
+			return today.AddDate(0, 0, -(7*n - 1)), today, TypeCustom, nil
+		default: // "m"
+			return addMonthsClamped(today, -n).AddDate(0, 0, 1), today, TypeCustom, nil
+		}
+	}
+
+	if m := quarterPattern.FindStringSubmatch(input); m != nil {
+		year, _ := strconv.Atoi(m[1])
+		quarter, _ := strconv.Atoi(m[2])
+		start := time.Date(year, c.FiscalYearStartMonth()+time.Month(3*(quarter-1)), 1, 0, 0, 0, 0, loc)
+		return start, start.AddDate(0, 3, -1), TypeQuarter, nil
+	}
+
+	if m := weekPattern.FindStringSubmatch(input); m != nil {
+		year, _ := strconv.Atoi(m[1])
+		week, _ := strconv.Atoi(m[2])
+		monday, ok := isoWeekMonday(year, week, loc)
+		if !ok {
+			return invalid("%q: %d has no ISO week %d", input, year, week)
+		}
+		start := c.StartOfWeek(monday)
+		return start, start.AddDate(0, 0, 6), TypeWeek, nil
+	}
+
+	if m := monthPattern.FindStringSubmatch(input); m != nil {
+		year, _ := strconv.Atoi(m[1])
+		month, _ := strconv.Atoi(m[2])
+		if month < 1 || month > 12 {
+			return invalid("%q: month must be 01-12", input)
+		}
+		start := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, loc)
+		return start, start.AddDate(0, 1, -1), TypeMonth, nil
+	}
+
+	if from, to, ok := strings.Cut(input, ".."); ok {
+		start, err := time.ParseInLocation("2006-01-02", from, loc)
+		if err != nil {
+			return invalid("%q is not a date (want YYYY-MM-DD..YYYY-MM-DD)", from)
+		}
+		end, err := time.ParseInLocation("2006-01-02", to, loc)
+		if err != nil {
+			return invalid("%q is not a date (want YYYY-MM-DD..YYYY-MM-DD)", to)
+		}
+		return start, end, TypeCustom, nil
+	}
+
+	if date, err := time.ParseInLocation("2006-01-02", input, loc); err == nil {
+		return date, date, TypeCustom, nil
+	}
+
+	return invalid("cannot understand period %q (try one of: %s)", input, ExpressionExamples)
+}
+
+// isoWeekMonday は ISO 8601 の年と週番号から、その週の月曜日を返す
+func isoWeekMonday(year, week int, loc *time.Location) (time.Time, bool) {
+	if week < 1 {
+		return time.Time{}, false
+	}
+	// 1月4日を含む週が第1週
+	jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, loc)
+	offset := (int(jan4.Weekday()) + 6) % 7
+	monday := jan4.AddDate(0, 0, -offset+7*(week-1))
+	if y, w := monday.ISOWeek(); y != year || w != week {
+		return time.Time{}, false
+	}
+	return monday, true
+}
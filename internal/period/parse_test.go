@@ -0,0 +1,152 @@
+package period
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	apperrors "github.com/taikicoco/shiraberu/internal/errors"
+)
+
+func TestParse(t *testing.T) {
+	now := time.Date(2025, 5, 20, 14, 30, 0, 0, time.UTC) // Tuesday
+	date := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		expr      string
+		wantStart time.Time
+		wantEnd   time.Time
+		wantType  Type
+	}{
+		{"yesterday", date(2025, 5, 19), date(2025, 5, 19), TypeCustom},
+		{"this-week", date(2025, 5, 19), date(2025, 5, 20), TypeWeek},
+		{"last-7d", date(2025, 5, 14), date(2025, 5, 20), TypeCustom},
+		{"last-1d", date(2025, 5, 20), date(2025, 5, 20), TypeCustom},
+		{"last-2w", date(2025, 5, 7), date(2025, 5, 20), TypeCustom},
+		{"last-3m", date(2025, 2, 21), date(2025, 5, 20), TypeCustom},
+		{"2025-Q1", date(2025, 1, 1), date(2025, 3, 31), TypeQuarter},
+		{"2024-q4", date(2024, 10, 1), date(2024, 12, 31), TypeQuarter},
+		{"2025-Q2", date(2025, 4, 1), date(2025, 5, 20), TypeQuarter}, // 今日までに切り詰める
+		{"2025-04", date(2025, 4, 1), date(2025, 4, 30), TypeMonth},
+		{"2024-02", date(2024, 2, 1), date(2024, 2, 29), TypeMonth},
+		{"2025-W18", date(2025, 4, 28), date(2025, 5, 4), TypeWeek},
+		{"2025-W01", date(2024, 12, 30), date(2025, 1, 5), TypeWeek},
+		{"2020-W53", date(2020, 12, 28), date(2021, 1, 3), TypeWeek},
+		{"2025-05-01", date(2025, 5, 1), date(2025, 5, 1), TypeCustom},
+		{"2025-05-01..2025-05-15", date(2025, 5, 1), date(2025, 5, 15), TypeCustom},
+		{"since 2025-04-01", date(2025, 4, 1), date(2025, 5, 20), TypeCustom},
+		{"  Since   2025-04-01 ", date(2025, 4, 1), date(2025, 5, 20), TypeCustom},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			start, end, periodType, err := Parse(tt.expr, now)
+			if err != nil {
+				t.Fatalf("Parse(%q) failed: %v", tt.expr, err)
+			}
+			if !start.Equal(tt.wantStart) || !end.Equal(tt.wantEnd) {
+				t.Errorf("Parse(%q): got %v - %v, want %v - %v", tt.expr, start, end, tt.wantStart, tt.wantEnd)
+			}
+			if periodType != tt.wantType {
+				t.Errorf("Parse(%q) type: got %q, want %q", tt.expr, periodType, tt.wantType)
+			}
+		})
+	}
+}
+
+func TestParse_LastMonthsAtMonthEnd(t *testing.T) {
+	date := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		today     time.Time
+		expr      string
+		wantStart time.Time
+	}{
+		{date(2025, 3, 31), "last-1m", date(2025, 3, 1)}, // 2/28 の翌日
+		{date(2025, 3, 30), "last-1m", date(2025, 3, 1)},
+		{date(2025, 3, 29), "last-1m", date(2025, 3, 1)},
+		{date(2025, 3, 28), "last-1m", date(2025, 3, 1)},
+		{date(2024, 3, 31), "last-1m", date(2024, 3, 1)}, // うるう年: 2/29 の翌日
+		{date(2024, 3, 30), "last-1m", date(2024, 3, 1)},
+		{date(2024, 3, 29), "last-1m", date(2024, 3, 1)},
+		{date(2024, 3, 28), "last-1m", date(2024, 2, 29)},
+		{date(2025, 5, 31), "last-1m", date(2025, 5, 1)},
+		{date(2025, 5, 30), "last-1m", date(2025, 5, 1)},
+		{date(2025, 5, 29), "last-1m", date(2025, 4, 30)},
+		{date(2024, 4, 30), "last-2m", date(2024, 3, 1)},
+		{date(2024, 4, 29), "last-2m", date(2024, 3, 1)},
+		{date(2025, 1, 31), "last-1m", date(2025, 1, 1)},
+		{date(2025, 12, 31), "last-10m", date(2025, 3, 1)},
+		{date(2024, 8, 31), "last-6m", date(2024, 3, 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.today.Format("2006-01-02")+" "+tt.expr, func(t *testing.T) {
+			now := tt.today.Add(14 * time.Hour)
+			start, end, _, err := Parse(tt.expr, now)
+			if err != nil {
+				t.Fatalf("Parse(%q) failed: %v", tt.expr, err)
+			}
+			if !start.Equal(tt.wantStart) || !end.Equal(tt.today) {
+				t.Errorf("Parse(%q) on %s: got %v - %v, want %v - %v", tt.expr, tt.today.Format("2006-01-02"), start, end, tt.wantStart, tt.today)
+			}
+		})
+	}
+}
+
+func TestCalendar_Parse(t *testing.T) {
+	cal := &Calendar{WeekStart: time.Sunday, FiscalYearStart: time.April}
+	now := time.Date(2025, 5, 20, 14, 30, 0, 0, time.UTC)
+	date := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		expr      string
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{"2024-Q4", date(2025, 1, 1), date(2025, 3, 31)},
+		{"2024-Q1", date(2024, 4, 1), date(2024, 6, 30)},
+		{"2025-W18", date(2025, 4, 27), date(2025, 5, 3)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			start, end, _, err := cal.Parse(tt.expr, now)
+			if err != nil {
+				t.Fatalf("Parse(%q) failed: %v", tt.expr, err)
+			}
+			if !start.Equal(tt.wantStart) || !end.Equal(tt.wantEnd) {
+				t.Errorf("Parse(%q): got %v - %v, want %v - %v", tt.expr, start, end, tt.wantStart, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	now := time.Date(2025, 5, 20, 14, 30, 0, 0, time.UTC)
+
+	tests := []string{
+		"",
+		"last-0d",
+		"last-7y",
+		"2025-Q5",
+		"2025-13",
+		"2025-W54",
+		"2025-W00",
+		"2025-02-30",
+		"2025-05-10..2025-05-01",
+		"2025-05-01..soon",
+		"since yesterday",
+		"2025-06", // 未来
+		"next-week",
+	}
+
+	for _, expr := range tests {
+		t.Run(expr, func(t *testing.T) {
+			_, _, _, err := Parse(expr, now)
+			if !errors.Is(err, apperrors.ErrInvalidDate) {
+				t.Errorf("Parse(%q) error: got %v, want ErrInvalidDate", expr, err)
+			}
+		})
+	}
+}
@@ -1,21 +1,131 @@
 package period
 
-import "time"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
 
 // Type は期間の種類を表す
 type Type string
 
 const (
-	TypeWeek   Type = "week"
-	TypeMonth  Type = "month"
-	TypeCustom Type = "custom"
+	TypeWeek    Type = "week"
+	TypeMonth   Type = "month"
+	TypeQuarter Type = "quarter"
+	TypeHalf    Type = "half"
+	TypeYear    Type = "year" // 会計年度
+	TypeCustom  Type = "custom"
 )
 
-// CalcPrevious は指定された期間の直前の同等期間を計算する
+// Calendar は週の始まりや会計年度の開始月など期間計算の基準を表す。
+// nil の *Calendar はデフォルト（月曜始まり、1月始まりの会計年度）として扱われる
+type Calendar struct {
+	WeekStart time.Weekday `json:"weekStart"`
+	// FiscalYearStart は会計年度の開始月（0 の場合は1月）。四半期・半期も開始月から数える
+	FiscalYearStart time.Month `json:"fiscalYearStart,omitempty"`
+}
+
+// FirstDayOfWeek は週の開始曜日を返す
+func (c *Calendar) FirstDayOfWeek() time.Weekday {
+	if c == nil {
+		return time.Monday
+	}
+	return c.WeekStart
+}
+
+// StartOfWeek は t を含む週の開始日（0時）を返す
+func (c *Calendar) StartOfWeek(t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	offset := (int(day.Weekday()) - int(c.FirstDayOfWeek()) + 7) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+// FiscalYearStartMonth は会計年度の開始月を返す
+func (c *Calendar) FiscalYearStartMonth() time.Month {
+	if c == nil || c.FiscalYearStart < time.January || c.FiscalYearStart > time.December {
+		return time.January
+	}
+	return c.FiscalYearStart
+}
+
+// startOfSpan は会計年度の開始月を起点に months ヶ月単位で区切った期間のうち、t を含む期間の開始日を返す
+func (c *Calendar) startOfSpan(t time.Time, months int) time.Time {
+	offset := (int(t.Month()) - int(c.FiscalYearStartMonth()) + 12) % 12
+	return time.Date(t.Year(), t.Month()-time.Month(offset%months), 1, 0, 0, 0, 0, t.Location())
+}
+
+// StartOfQuarter は t を含む四半期の開始日を返す
+func (c *Calendar) StartOfQuarter(t time.Time) time.Time {
+	return c.startOfSpan(t, 3)
+}
+
+// StartOfHalf は t を含む半期の開始日を返す
+func (c *Calendar) StartOfHalf(t time.Time) time.Time {
+	return c.startOfSpan(t, 6)
+}
+
+// StartOfFiscalYear は t を含む会計年度の開始日を返す
+func (c *Calendar) StartOfFiscalYear(t time.Time) time.Time {
+	return c.startOfSpan(t, 12)
+}
+
+// QuarterLabel は t を含む四半期の表示名を返す。
+// 1月始まりの場合は "2025 Q1"、それ以外は開始年で数えた "FY2025 Q1" 形式
+func (c *Calendar) QuarterLabel(t time.Time) string {
+	fyStart := c.StartOfFiscalYear(t)
+	quarter := (int(t.Month())-int(fyStart.Month())+12)%12/3 + 1
+	if fyStart.Month() == time.January {
+		return fmt.Sprintf("%d Q%d", fyStart.Year(), quarter)
+	}
+	return fmt.Sprintf("FY%d Q%d", fyStart.Year(), quarter)
+}
+
+// ParseMonth は月を数値（1〜12）または英語名（april, apr など）から time.Month に変換する
+func ParseMonth(s string) (time.Month, error) {
+	name := strings.ToLower(strings.TrimSpace(s))
+	if n, err := strconv.Atoi(name); err == nil {
+		if n >= 1 && n <= 12 {
+			return time.Month(n), nil
+		}
+		return 0, fmt.Errorf("month %d is out of range 1-12", n)
+	}
+	if len(name) >= 3 {
+		for m := time.January; m <= time.December; m++ {
+			full := strings.ToLower(m.String())
+			if name == full || name == full[:3] {
+				return m, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("unknown month %q", s)
+}
+
+// ParseWeekday は曜日名（sunday, mon など。大文字小文字は区別しない）を time.Weekday に変換する
+func ParseWeekday(s string) (time.Weekday, error) {
+	name := strings.ToLower(strings.TrimSpace(s))
+	if len(name) >= 3 {
+		for d := time.Sunday; d <= time.Saturday; d++ {
+			full := strings.ToLower(d.String())
+			if name == full || name == full[:3] {
+				return d, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("unknown weekday %q", s)
+}
+
+// CalcPrevious はデフォルトの Calendar で直前の同等期間を計算する
 func CalcPrevious(startDate, endDate time.Time, periodType Type) (time.Time, time.Time) {
+	return (*Calendar)(nil).CalcPrevious(startDate, endDate, periodType)
+}
+
+// CalcPrevious は指定された期間の直前の同等期間を計算する
+func (c *Calendar) CalcPrevious(startDate, endDate time.Time, periodType Type) (time.Time, time.Time) {
 	switch periodType {
 	case TypeWeek:
-		// Previous week (Monday to Sunday)
+		// Previous 7 days (a full week when startDate is the start of the week)
 		prevEndDate := startDate.AddDate(0, 0, -1)
 		prevStartDate := prevEndDate.AddDate(0, 0, -6)
 		return prevStartDate, prevEndDate
@@ -26,6 +136,20 @@ func CalcPrevious(startDate, endDate time.Time, periodType Type) (time.Time, tim
 		prevStartDate := time.Date(prevEndDate.Year(), prevEndDate.Month(), 1, 0, 0, 0, 0, prevEndDate.Location())
 		return prevStartDate, prevEndDate
 
+	case TypeQuarter, TypeHalf, TypeYear:
+		// Previous quarter / half / fiscal year (first to last day)
+		prevEndDate := startDate.AddDate(0, 0, -1)
+		var prevStartDate time.Time
+		switch periodType {
+		case TypeQuarter:
+			prevStartDate = c.StartOfQuarter(prevEndDate)
+		case TypeHalf:
+			prevStartDate = c.StartOfHalf(prevEndDate)
+		default:
+			prevStartDate = c.StartOfFiscalYear(prevEndDate)
+		}
+		return prevStartDate, prevEndDate
+
 	default: // TypeCustom
 		// Same duration before
 		duration := endDate.Sub(startDate) + 24*time.Hour
@@ -34,3 +158,82 @@ func CalcPrevious(startDate, endDate time.Time, periodType Type) (time.Time, tim
 		return prevStartDate, prevEndDate
 	}
 }
+
+// プリセット名
+const (
+	PresetToday     = "today"
+	PresetYesterday = "yesterday"
+	PresetThisWeek  = "this-week"
+	PresetLastWeek  = "last-week"
+	PresetThisMonth = "this-month"
+	PresetLastMonth = "last-month"
+
+	PresetThisQuarter    = "this-quarter"
+	PresetLastQuarter    = "last-quarter"
+	PresetThisHalf       = "this-half"
+	PresetLastHalf       = "last-half"
+	PresetThisFiscalYear = "this-fiscal-year"
+	PresetLastFiscalYear = "last-fiscal-year"
+)
+
+// Presets は指定可能なプリセット名の一覧
+var Presets = []string{
+	PresetToday,
+	PresetYesterday,
+	PresetThisWeek,
+	PresetLastWeek,
+	PresetThisMonth,
+	PresetLastMonth,
+	PresetThisQuarter,
+	PresetLastQuarter,
+	PresetThisHalf,
+	PresetLastHalf,
+	PresetThisFiscalYear,
+	PresetLastFiscalYear,
+}
+
+// Preset はデフォルトの Calendar（月曜始まり、1月始まりの会計年度）でプリセットの期間を計算する
+func Preset(name string, now time.Time) (start, end time.Time, periodType Type, ok bool) {
+	return (*Calendar)(nil).Preset(name, now)
+}
+
+// Preset はプリセット名に対応する期間を now の日付を基準に計算する。
+// 未知のプリセット名の場合は ok=false を返す
+func (c *Calendar) Preset(name string, now time.Time) (start, end time.Time, periodType Type, ok bool) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	thisWeekStart := c.StartOfWeek(today)
+	thisMonthStart := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, today.Location())
+
+	switch name {
+	case PresetToday:
+		return today, today, TypeCustom, true
+	case PresetYesterday:
+		yesterday := today.AddDate(0, 0, -1)
+		return yesterday, yesterday, TypeCustom, true
+	case PresetThisWeek:
+		return thisWeekStart, today, TypeWeek, true
+	case PresetLastWeek:
+		return thisWeekStart.AddDate(0, 0, -7), thisWeekStart.AddDate(0, 0, -1), TypeWeek, true
+	case PresetThisMonth:
+		return thisMonthStart, today, TypeMonth, true
+	case PresetLastMonth:
+		return thisMonthStart.AddDate(0, -1, 0), thisMonthStart.AddDate(0, 0, -1), TypeMonth, true
+	case PresetThisQuarter:
+		return c.StartOfQuarter(today), today, TypeQuarter, true
+	case PresetLastQuarter:
+		thisQuarterStart := c.StartOfQuarter(today)
+		return thisQuarterStart.AddDate(0, -3, 0), thisQuarterStart.AddDate(0, 0, -1), TypeQuarter, true
+	case PresetThisHalf:
+		return c.StartOfHalf(today), today, TypeHalf, true
+	case PresetLastHalf:
+		thisHalfStart := c.StartOfHalf(today)
+		return thisHalfStart.AddDate(0, -6, 0), thisHalfStart.AddDate(0, 0, -1), TypeHalf, true
+	case PresetThisFiscalYear:
+		return c.StartOfFiscalYear(today), today, TypeYear, true
+	case PresetLastFiscalYear:
+		thisYearStart := c.StartOfFiscalYear(today)
+		return thisYearStart.AddDate(-1, 0, 0), thisYearStart.AddDate(0, 0, -1), TypeYear, true
+	default:
+		return time.Time{}, time.Time{}, "", false
+	}
+}
@@ -129,3 +129,259 @@ func TestCalcPrevious_Custom(t *testing.T) {
 		})
 	}
 }
+
+func TestPreset(t *testing.T) {
+	now := time.Date(2025, 1, 15, 14, 30, 0, 0, time.UTC) // Wednesday
+	date := func(m time.Month, d int) time.Time { return time.Date(2025, m, d, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name      string
+		wantStart time.Time
+		wantEnd   time.Time
+		wantType  Type
+	}{
+		{PresetToday, date(1, 15), date(1, 15), TypeCustom},
+		{PresetYesterday, date(1, 14), date(1, 14), TypeCustom},
+		{PresetThisWeek, date(1, 13), date(1, 15), TypeWeek},
+		{PresetLastWeek, date(1, 6), date(1, 12), TypeWeek},
+		{PresetThisMonth, date(1, 1), date(1, 15), TypeMonth},
+		{PresetLastMonth, time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), TypeMonth},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, periodType, ok := Preset(tt.name, now)
+			if !ok {
+				t.Fatalf("Preset(%q) returned ok=false", tt.name)
+			}
+			if !start.Equal(tt.wantStart) || !end.Equal(tt.wantEnd) {
+				t.Errorf("Preset(%q): got %v - %v, want %v - %v", tt.name, start, end, tt.wantStart, tt.wantEnd)
+			}
+			if periodType != tt.wantType {
+				t.Errorf("Preset(%q) type: got %q, want %q", tt.name, periodType, tt.wantType)
+			}
+		})
+	}
+}
+
+func TestPreset_Sunday(t *testing.T) {
+	now := time.Date(2025, 1, 19, 9, 0, 0, 0, time.UTC) // Sunday
+	start, end, _, _ := Preset(PresetThisWeek, now)
+	if want := time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC); !start.Equal(want) {
+		t.Errorf("this-week start on Sunday: got %v, want %v", start, want)
+	}
+	if !end.Equal(time.Date(2025, 1, 19, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("this-week end on Sunday: got %v", end)
+	}
+}
+
+func TestPreset_Unknown(t *testing.T) {
+	if _, _, _, ok := Preset("next-decade", time.Now()); ok {
+		t.Error("Preset with unknown name should return ok=false")
+	}
+}
+
+func TestCalendar_StartOfWeek(t *testing.T) {
+	date := func(d int) time.Time { return time.Date(2025, 1, d, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name      string
+		cal       *Calendar
+		t         time.Time
+		wantStart time.Time
+	}{
+		{"nil calendar is Monday start", nil, date(15), date(13)},
+		{"Monday start on Sunday", &Calendar{WeekStart: time.Monday}, date(19), date(13)},
+		{"Sunday start on Wednesday", &Calendar{WeekStart: time.Sunday}, date(15), date(12)},
+		{"Sunday start on Sunday", &Calendar{WeekStart: time.Sunday}, date(19), date(19)},
+		{"Sunday start on Saturday", &Calendar{WeekStart: time.Sunday}, date(18), date(12)},
+		{"time of day is dropped", &Calendar{WeekStart: time.Sunday}, time.Date(2025, 1, 15, 23, 59, 0, 0, time.UTC), date(12)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cal.StartOfWeek(tt.t); !got.Equal(tt.wantStart) {
+				t.Errorf("StartOfWeek(%v): got %v, want %v", tt.t, got, tt.wantStart)
+			}
+		})
+	}
+}
+
+func TestCalendar_Preset_SundayStart(t *testing.T) {
+	cal := &Calendar{WeekStart: time.Sunday}
+	now := time.Date(2025, 1, 15, 14, 30, 0, 0, time.UTC) // Wednesday
+	date := func(d int) time.Time { return time.Date(2025, 1, d, 0, 0, 0, 0, time.UTC) }
+
+	start, end, _, _ := cal.Preset(PresetThisWeek, now)
+	if !start.Equal(date(12)) || !end.Equal(date(15)) {
+		t.Errorf("this-week: got %v - %v, want 2025-01-12 - 2025-01-15", start, end)
+	}
+
+	start, end, _, _ = cal.Preset(PresetLastWeek, now)
+	if !start.Equal(date(5)) || !end.Equal(date(11)) {
+		t.Errorf("last-week: got %v - %v, want 2025-01-05 - 2025-01-11", start, end)
+	}
+
+	prevStart, prevEnd := cal.CalcPrevious(start, end, TypeWeek)
+	if want := time.Date(2024, 12, 29, 0, 0, 0, 0, time.UTC); !prevStart.Equal(want) || !prevEnd.Equal(date(4)) {
+		t.Errorf("CalcPrevious: got %v - %v, want 2024-12-29 - 2025-01-04", prevStart, prevEnd)
+	}
+}
+
+func TestParseWeekday(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    time.Weekday
+		wantErr bool
+	}{
+		{"monday", time.Monday, false},
+		{"Sunday", time.Sunday, false},
+		{"SAT", time.Saturday, false},
+		{" tue ", time.Tuesday, false},
+		{"", 0, true},
+		{"su", 0, true},
+		{"someday", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseWeekday(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseWeekday(%q) error: got %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseWeekday(%q): got %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalendar_StartOfSpans(t *testing.T) {
+	date := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+	april := &Calendar{FiscalYearStart: time.April}
+
+	tests := []struct {
+		name          string
+		cal           *Calendar
+		t             time.Time
+		wantQuarter   time.Time
+		wantHalf      time.Time
+		wantFiscal    time.Time
+		wantQuarterLb string
+	}{
+		{"calendar year, February", nil, date(2025, 2, 14), date(2025, 1, 1), date(2025, 1, 1), date(2025, 1, 1), "2025 Q1"},
+		{"calendar year, November", nil, date(2025, 11, 30), date(2025, 10, 1), date(2025, 7, 1), date(2025, 1, 1), "2025 Q4"},
+		{"April fiscal year, May", april, date(2025, 5, 10), date(2025, 4, 1), date(2025, 4, 1), date(2025, 4, 1), "FY2025 Q1"},
+		{"April fiscal year, December", april, date(2025, 12, 31), date(2025, 10, 1), date(2025, 10, 1), date(2025, 4, 1), "FY2025 Q3"},
+		{"April fiscal year, February", april, date(2026, 2, 1), date(2026, 1, 1), date(2025, 10, 1), date(2025, 4, 1), "FY2025 Q4"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cal.StartOfQuarter(tt.t); !got.Equal(tt.wantQuarter) {
+				t.Errorf("StartOfQuarter: got %v, want %v", got, tt.wantQuarter)
+			}
+			if got := tt.cal.StartOfHalf(tt.t); !got.Equal(tt.wantHalf) {
+				t.Errorf("StartOfHalf: got %v, want %v", got, tt.wantHalf)
+			}
+			if got := tt.cal.StartOfFiscalYear(tt.t); !got.Equal(tt.wantFiscal) {
+				t.Errorf("StartOfFiscalYear: got %v, want %v", got, tt.wantFiscal)
+			}
+			if got := tt.cal.QuarterLabel(tt.t); got != tt.wantQuarterLb {
+				t.Errorf("QuarterLabel: got %q, want %q", got, tt.wantQuarterLb)
+			}
+		})
+	}
+}
+
+func TestCalendar_Preset_Quarter(t *testing.T) {
+	cal := &Calendar{FiscalYearStart: time.April}
+	now := time.Date(2025, 5, 20, 10, 0, 0, 0, time.UTC)
+	date := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name      string
+		wantStart time.Time
+		wantEnd   time.Time
+		wantType  Type
+	}{
+		{PresetThisQuarter, date(2025, 4, 1), date(2025, 5, 20), TypeQuarter},
+		{PresetLastQuarter, date(2025, 1, 1), date(2025, 3, 31), TypeQuarter},
+		{PresetThisHalf, date(2025, 4, 1), date(2025, 5, 20), TypeHalf},
+		{PresetLastHalf, date(2024, 10, 1), date(2025, 3, 31), TypeHalf},
+		{PresetThisFiscalYear, date(2025, 4, 1), date(2025, 5, 20), TypeYear},
+		{PresetLastFiscalYear, date(2024, 4, 1), date(2025, 3, 31), TypeYear},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, periodType, ok := cal.Preset(tt.name, now)
+			if !ok {
+				t.Fatalf("Preset(%q) returned ok=false", tt.name)
+			}
+			if !start.Equal(tt.wantStart) || !end.Equal(tt.wantEnd) {
+				t.Errorf("Preset(%q): got %v - %v, want %v - %v", tt.name, start, end, tt.wantStart, tt.wantEnd)
+			}
+			if periodType != tt.wantType {
+				t.Errorf("Preset(%q) type: got %q, want %q", tt.name, periodType, tt.wantType)
+			}
+		})
+	}
+}
+
+func TestCalendar_CalcPrevious_Quarter(t *testing.T) {
+	cal := &Calendar{FiscalYearStart: time.April}
+	date := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name          string
+		startDate     time.Time
+		endDate       time.Time
+		periodType    Type
+		wantPrevStart time.Time
+		wantPrevEnd   time.Time
+	}{
+		{"full quarter", date(2025, 7, 1), date(2025, 9, 30), TypeQuarter, date(2025, 4, 1), date(2025, 6, 30)},
+		{"quarter to date", date(2025, 4, 1), date(2025, 5, 20), TypeQuarter, date(2025, 1, 1), date(2025, 3, 31)},
+		{"half", date(2025, 10, 1), date(2026, 3, 31), TypeHalf, date(2025, 4, 1), date(2025, 9, 30)},
+		{"fiscal year", date(2025, 4, 1), date(2026, 3, 31), TypeYear, date(2024, 4, 1), date(2025, 3, 31)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotStart, gotEnd := cal.CalcPrevious(tt.startDate, tt.endDate, tt.periodType)
+			if !gotStart.Equal(tt.wantPrevStart) || !gotEnd.Equal(tt.wantPrevEnd) {
+				t.Errorf("CalcPrevious: got %v - %v, want %v - %v", gotStart, gotEnd, tt.wantPrevStart, tt.wantPrevEnd)
+			}
+		})
+	}
+}
+
+func TestParseMonth(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    time.Month
+		wantErr bool
+	}{
+		{"4", time.April, false},
+		{"april", time.April, false},
+		{"Apr", time.April, false},
+		{"12", time.December, false},
+		{"0", 0, true},
+		{"13", 0, true},
+		{"ap", 0, true},
+		{"", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseMonth(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseMonth(%q) error: got %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseMonth(%q): got %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
@@ -1,83 +1,222 @@
 package pr
 
 import (
+	"errors"
 	"sort"
 	"time"
 
+	apperrors "github.com/taikicoco/shiraberu/internal/errors"
 	"github.com/taikicoco/shiraberu/internal/github"
+	"github.com/taikicoco/shiraberu/internal/period"
 	"github.com/taikicoco/shiraberu/internal/timezone"
 )
 
 // PRSearcher はPR検索機能を抽象化するインターフェース
 type PRSearcher interface {
 	Username() string
+	Host() string
 	SearchPRs(org string, query string, dateFilter string) ([]github.PullRequest, error)
+	SearchReviewedPRs(org string, reviewer string, dateFilter string) ([]github.PullRequest, error)
 }
 
 type DailyPRs struct {
-	Date     time.Time
-	Opened   []github.PullRequest
-	Draft    []github.PullRequest
-	Merged   []github.PullRequest
-	Reviewed []github.PullRequest
+	Date     time.Time            `json:"date"`
+	Opened   []github.PullRequest `json:"opened"`
+	Draft    []github.PullRequest `json:"draft"`
+	Merged   []github.PullRequest `json:"merged"`
+	Closed   []github.PullRequest `json:"closed"` // マージされずにクローズされたPR
+	Reviewed []github.PullRequest `json:"reviewed"`
 }
 
 type Report struct {
-	GeneratedAt time.Time
-	StartDate   time.Time
-	EndDate     time.Time
-	Org         string
-	Username    string
-	Days        []DailyPRs
+	GeneratedAt time.Time  `json:"generatedAt"`
+	StartDate   time.Time  `json:"startDate"`
+	EndDate     time.Time  `json:"endDate"`
+	Host        string     `json:"host,omitempty"`     // データ取得元のGitHubホスト名 (例: github.com)
+	Timezone    string     `json:"timezone,omitempty"` // 日付の区切りに使用したタイムゾーン (例: Europe/Berlin)
+	Orgs        []string   `json:"orgs"`               // 集計対象のOrganization
+	Username    string     `json:"username"`
+	Days        []DailyPRs `json:"days"`
+	// Warnings は取得結果が不完全な場合などの警告メッセージ
+	Warnings []string `json:"warnings,omitempty"`
+	// LabelFilter は適用したラベルの絞り込み条件
+	LabelFilter LabelFilter `json:"labelFilter"`
+	// Calendar は週の集計に使用する期間計算の基準（nil の場合は月曜始まり）
+	Calendar *period.Calendar `json:"calendar,omitempty"`
 }
 
+// DraftMode はOpened/Draftの判定に使用するDraft状態の基準
+type DraftMode string
+
+const (
+	// DraftAtCreation はPR作成時点のDraft状態で判定する（過去のレポートの数値が変わらない）
+	DraftAtCreation DraftMode = "creation"
+	// DraftAtFetch はデータ取得時点のDraft状態で判定する
+	DraftAtFetch DraftMode = "fetch"
+)
+
 type Fetcher struct {
-	client PRSearcher
+	client      PRSearcher
+	draftMode   DraftMode
+	labelFilter LabelFilter
+	location    *time.Location
+	calendar    *period.Calendar
 }
 
-func NewFetcher(client PRSearcher) *Fetcher {
-	return &Fetcher{client: client}
+// FetcherOption はFetcherの設定オプション
+type FetcherOption func(*Fetcher)
+
+// WithDraftMode はDraft判定の基準を設定する
+func WithDraftMode(mode DraftMode) FetcherOption {
+	return func(f *Fetcher) {
+		f.draftMode = mode
+	}
 }
 
-func (f *Fetcher) Fetch(org, username string, startDate, endDate time.Time) (*Report, error) {
+// WithLabelFilter はラベルによる絞り込み条件を設定する
+func WithLabelFilter(filter LabelFilter) FetcherOption {
+	return func(f *Fetcher) {
+		f.labelFilter = filter
+	}
+}
 
-	// JST timezone for date range
-	startTime := time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 0, 0, 0, 0, timezone.JST)
-	endTime := time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 23, 59, 59, 0, timezone.JST)
+// WithLocation は日付の区切りに使用するタイムゾーンを設定する（デフォルトはローカルタイムゾーン）
+func WithLocation(loc *time.Location) FetcherOption {
+	return func(f *Fetcher) {
+		f.location = loc
+	}
+}
 
-	dateRange := startTime.Format(time.RFC3339) + ".." + endTime.Format(time.RFC3339)
+// WithCalendar はレポートに記録する期間計算の基準（週の始まりなど）を設定する
+func WithCalendar(cal *period.Calendar) FetcherOption {
+	return func(f *Fetcher) {
+		f.calendar = cal
+	}
+}
 
-	// Opened PRs
-	openedPRs, err := f.client.SearchPRs(org, "is:pr author:"+username+" is:open", "created:"+dateRange)
-	if err != nil {
-		return nil, err
+func NewFetcher(client PRSearcher, opts ...FetcherOption) *Fetcher {
+	f := &Fetcher{client: client, draftMode: DraftAtCreation, location: time.Local}
+	for _, opt := range opts {
+		opt(f)
 	}
+	return f
+}
 
-	// Merged PRs
-	mergedPRs, err := f.client.SearchPRs(org, "is:pr author:"+username+" is:merged", "merged:"+dateRange)
-	if err != nil {
-		return nil, err
+// Fetch は指定したOrganization（複数可）のPRを取得し、1つのレポートにまとめる
+func (f *Fetcher) Fetch(orgs []string, username string, startDate, endDate time.Time) (*Report, error) {
+	if len(orgs) == 0 {
+		return nil, apperrors.ErrOrgRequired
 	}
 
-	// Reviewed PRs
-	reviewedPRs, err := f.client.SearchPRs(org, "is:pr reviewed-by:"+username+" -author:"+username, "updated:"+dateRange)
-	if err != nil {
-		return nil, err
+	// 設定したタイムゾーンの日付の区切りで期間を決める
+	loc := f.location
+	startTime := time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 0, 0, 0, 0, loc)
+	endTime := time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 23, 59, 59, 0, loc)
+
+	dateRange := startTime.Format(time.RFC3339) + ".." + endTime.Format(time.RFC3339)
+
+	// レビュー後にPRが更新されている場合もあるため、updated は期間開始〜現在で検索する
+	updatedEnd := endTime
+	if now := time.Now().In(loc); now.After(updatedEnd) {
+		updatedEnd = now.Truncate(time.Second)
 	}
+	updatedRange := startTime.Format(time.RFC3339) + ".." + updatedEnd.Format(time.RFC3339)
 
-	days := groupByDate(openedPRs, mergedPRs, reviewedPRs)
+	var warnings searchWarnings
+	var openedPRs, mergedPRs, closedPRs, reviewedPRs []github.PullRequest
+
+	for _, org := range orgs {
+		// Opened PRs（現在の状態に関わらず期間内に作成されたPR）
+		opened, err := warnings.collect(f.client.SearchPRs(org, "is:pr author:"+username, "created:"+dateRange))
+		if err != nil {
+			return nil, err
+		}
 
+		// Merged PRs
+		merged, err := warnings.collect(f.client.SearchPRs(org, "is:pr author:"+username+" is:merged", "merged:"+dateRange))
+		if err != nil {
+			return nil, err
+		}
+
+		// Closed (unmerged) PRs
+		closed, err := warnings.collect(f.client.SearchPRs(org, "is:pr author:"+username+" is:closed is:unmerged", "closed:"+dateRange))
+		if err != nil {
+			return nil, err
+		}
+
+		// Reviewed PRs
+		reviewed, err := warnings.collect(f.client.SearchReviewedPRs(org, username, "updated:"+updatedRange))
+		if err != nil {
+			return nil, err
+		}
+
+		openedPRs = append(openedPRs, opened...)
+		mergedPRs = append(mergedPRs, merged...)
+		closedPRs = append(closedPRs, closed...)
+		reviewedPRs = append(reviewedPRs, reviewed...)
+	}
+	reviewedPRs = filterReviewsInRange(reviewedPRs, startTime, endTime)
+
+	days := groupByDate(loc, f.draftMode,
+		f.labelFilter.Apply(openedPRs),
+		f.labelFilter.Apply(mergedPRs),
+		f.labelFilter.Apply(closedPRs),
+		f.labelFilter.Apply(reviewedPRs),
+	)
+
+	generatedAt := time.Now().In(loc)
 	return &Report{
-		GeneratedAt: time.Now(),
+		GeneratedAt: generatedAt,
 		StartDate:   startDate,
 		EndDate:     endDate,
-		Org:         org,
+		Host:        f.client.Host(),
+		Timezone:    timezone.Name(loc, generatedAt),
+		Orgs:        orgs,
 		Username:    username,
 		Days:        days,
+		Warnings:    []string(warnings),
+		LabelFilter: f.labelFilter,
+		Calendar:    f.calendar,
 	}, nil
 }
 
-func groupByDate(opened, merged, reviewed []github.PullRequest) []DailyPRs {
+// searchWarnings は検索時に発生した警告を蓄積する
+type searchWarnings []string
+
+// collect は検索結果を受け取り、上限で打ち切られた場合は
+// 取得できた結果を返しつつ警告として記録する
+func (w *searchWarnings) collect(prs []github.PullRequest, err error) ([]github.PullRequest, error) {
+	if errors.Is(err, apperrors.ErrSearchTruncated) {
+		*w = append(*w, err.Error())
+		return prs, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return prs, nil
+}
+
+// filterReviewsInRange は各PRのレビューを期間内に提出されたものに絞り込み、
+// 期間内のレビューが1件もないPRを除外する
+func filterReviewsInRange(prs []github.PullRequest, start, end time.Time) []github.PullRequest {
+	result := make([]github.PullRequest, 0, len(prs))
+	for _, p := range prs {
+		var reviews []github.Review
+		for _, r := range p.Reviews {
+			if !r.SubmittedAt.Before(start) && !r.SubmittedAt.After(end) {
+				reviews = append(reviews, r)
+			}
+		}
+		if len(reviews) == 0 {
+			continue
+		}
+		p.Reviews = reviews
+		result = append(result, p)
+	}
+	return result
+}
+
+func groupByDate(loc *time.Location, mode DraftMode, opened, merged, closed, reviewed []github.PullRequest) []DailyPRs {
 	dateMap := make(map[string]*DailyPRs)
 
 	addPR := func(pr github.PullRequest, category string) {
@@ -85,16 +224,22 @@ func groupByDate(opened, merged, reviewed []github.PullRequest) []DailyPRs {
 		if category == "merged" && pr.MergedAt != nil {
 			date = *pr.MergedAt
 		}
+		if category == "closed" && pr.ClosedAt != nil {
+			date = *pr.ClosedAt
+		}
 		if category == "reviewed" {
 			date = pr.UpdatedAt
+			if len(pr.Reviews) > 0 {
+				date = pr.Reviews[0].SubmittedAt
+			}
 		}
 
-		// UTCからJSTに変換してからグループ化
-		dateJST := date.In(timezone.JST)
-		dateStr := dateJST.Format("2006-01-02")
+		// 設定したタイムゾーンに変換してからグループ化
+		local := date.In(loc)
+		dateStr := local.Format("2006-01-02")
 		if _, ok := dateMap[dateStr]; !ok {
 			dateMap[dateStr] = &DailyPRs{
-				Date: time.Date(dateJST.Year(), dateJST.Month(), dateJST.Day(), 0, 0, 0, 0, timezone.JST),
+				Date: time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc),
 			}
 		}
 
@@ -105,13 +250,15 @@ func groupByDate(opened, merged, reviewed []github.PullRequest) []DailyPRs {
 			dateMap[dateStr].Draft = append(dateMap[dateStr].Draft, pr)
 		case "merged":
 			dateMap[dateStr].Merged = append(dateMap[dateStr].Merged, pr)
+		case "closed":
+			dateMap[dateStr].Closed = append(dateMap[dateStr].Closed, pr)
 		case "reviewed":
 			dateMap[dateStr].Reviewed = append(dateMap[dateStr].Reviewed, pr)
 		}
 	}
 
 	for _, pr := range opened {
-		if pr.IsDraft {
+		if isDraft(pr, mode) {
 			addPR(pr, "draft")
 		} else {
 			addPR(pr, "opened")
@@ -120,8 +267,13 @@ func groupByDate(opened, merged, reviewed []github.PullRequest) []DailyPRs {
 	for _, pr := range merged {
 		addPR(pr, "merged")
 	}
+	for _, pr := range closed {
+		addPR(pr, "closed")
+	}
 	for _, pr := range reviewed {
-		addPR(pr, "reviewed")
+		for _, dayPR := range splitByReviewDay(pr, loc) {
+			addPR(dayPR, "reviewed")
+		}
 	}
 
 	days := make([]DailyPRs, 0, len(dateMap))
@@ -135,3 +287,38 @@ func groupByDate(opened, merged, reviewed []github.PullRequest) []DailyPRs {
 
 	return days
 }
+
+// isDraft はDraft判定の基準に従ってPRがDraftかどうかを返す
+func isDraft(pr github.PullRequest, mode DraftMode) bool {
+	if mode == DraftAtFetch {
+		return pr.IsDraft
+	}
+	return pr.CreatedAsDraft
+}
+
+// splitByReviewDay はレビューを提出した日（loc の日付）ごとに、その日のレビューのみを持つPRに分割する。
+// レビュー情報がない場合はそのまま返す
+func splitByReviewDay(pr github.PullRequest, loc *time.Location) []github.PullRequest {
+	if len(pr.Reviews) == 0 {
+		return []github.PullRequest{pr}
+	}
+
+	var keys []string
+	byDay := make(map[string][]github.Review)
+	for _, r := range pr.Reviews {
+		key := r.SubmittedAt.In(loc).Format("2006-01-02")
+		if _, ok := byDay[key]; !ok {
+			keys = append(keys, key)
+		}
+		byDay[key] = append(byDay[key], r)
+	}
+	sort.Strings(keys)
+
+	result := make([]github.PullRequest, 0, len(keys))
+	for _, key := range keys {
+		dayPR := pr
+		dayPR.Reviews = byDay[key]
+		result = append(result, dayPR)
+	}
+	return result
+}
@@ -1,11 +1,15 @@
 package pr
 
 import (
+	"errors"
+	"fmt"
 	"strings"
 	"testing"
 	"time"
 
+	apperrors "github.com/taikicoco/shiraberu/internal/errors"
 	"github.com/taikicoco/shiraberu/internal/github"
+	"github.com/taikicoco/shiraberu/internal/period"
 	"github.com/taikicoco/shiraberu/internal/timezone"
 )
 
@@ -42,7 +46,7 @@ func TestGroupByDate(t *testing.T) {
 		},
 	}
 
-	days := groupByDate(opened, merged, reviewed)
+	days := groupByDate(timezone.JST, DraftAtFetch, opened, merged, nil, reviewed)
 
 	// Should have 2 days
 	if len(days) != 2 {
@@ -80,7 +84,7 @@ func TestGroupByDate(t *testing.T) {
 }
 
 func TestGroupByDate_Empty(t *testing.T) {
-	days := groupByDate(nil, nil, nil)
+	days := groupByDate(timezone.JST, DraftAtCreation, nil, nil, nil, nil)
 	if len(days) != 0 {
 		t.Errorf("len(days): got %d, want 0", len(days))
 	}
@@ -96,7 +100,7 @@ func TestGroupByDate_UTCToJST(t *testing.T) {
 		},
 	}
 
-	days := groupByDate(opened, nil, nil)
+	days := groupByDate(timezone.JST, DraftAtCreation, opened, nil, nil, nil)
 
 	if len(days) != 1 {
 		t.Fatalf("len(days): got %d, want 1", len(days))
@@ -111,8 +115,10 @@ func TestGroupByDate_UTCToJST(t *testing.T) {
 // MockPRSearcher is a mock implementation of github.PRSearcher
 type MockPRSearcher struct {
 	username  string
+	host      string
 	openedPRs []github.PullRequest
 	mergedPRs []github.PullRequest
+	closedPRs []github.PullRequest
 	reviewPRs []github.PullRequest
 	err       error
 }
@@ -121,25 +127,36 @@ func (m *MockPRSearcher) Username() string {
 	return m.username
 }
 
+func (m *MockPRSearcher) Host() string {
+	return m.host
+}
+
 func (m *MockPRSearcher) SearchPRs(org string, query string, dateFilter string) ([]github.PullRequest, error) {
 	if m.err != nil {
 		return nil, m.err
 	}
 
 	// Return different PRs based on query
-	if strings.Contains(query, "is:open") {
+	if strings.Contains(query, "is:unmerged") {
+		return m.closedPRs, nil
+	}
+	if strings.HasPrefix(dateFilter, "created:") {
 		return m.openedPRs, nil
 	}
 	if strings.Contains(query, "is:merged") {
 		return m.mergedPRs, nil
 	}
-	if strings.Contains(query, "reviewed-by:") {
-		return m.reviewPRs, nil
-	}
 
 	return nil, nil
 }
 
+func (m *MockPRSearcher) SearchReviewedPRs(org string, reviewer string, dateFilter string) ([]github.PullRequest, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.reviewPRs, nil
+}
+
 func TestNewFetcher(t *testing.T) {
 	mock := &MockPRSearcher{username: "testuser"}
 	fetcher := NewFetcher(mock)
@@ -155,6 +172,7 @@ func TestFetcher_Fetch(t *testing.T) {
 
 	mock := &MockPRSearcher{
 		username: "testuser",
+		host:     "ghe.example.com",
 		openedPRs: []github.PullRequest{
 			{
 				Title:     "Opened PR",
@@ -174,22 +192,28 @@ func TestFetcher_Fetch(t *testing.T) {
 				Title:     "Reviewed PR",
 				URL:       "https://github.com/test/repo/pull/3",
 				UpdatedAt: updatedAt,
+				Reviews: []github.Review{
+					{State: github.ReviewApproved, SubmittedAt: updatedAt},
+				},
 			},
 		},
 	}
 
-	fetcher := NewFetcher(mock)
+	fetcher := NewFetcher(mock, WithLocation(timezone.JST))
 
 	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST)
 	endDate := time.Date(2025, 1, 31, 0, 0, 0, 0, timezone.JST)
 
-	report, err := fetcher.Fetch("test-org", "testuser", startDate, endDate)
+	report, err := fetcher.Fetch([]string{"test-org"}, "testuser", startDate, endDate)
 	if err != nil {
 		t.Fatalf("Fetch() failed: %v", err)
 	}
 
-	if report.Org != "test-org" {
-		t.Errorf("Org: got %q, want %q", report.Org, "test-org")
+	if len(report.Orgs) != 1 || report.Orgs[0] != "test-org" {
+		t.Errorf("Orgs: got %q, want [test-org]", report.Orgs)
+	}
+	if report.Host != "ghe.example.com" {
+		t.Errorf("Host: got %q, want %q", report.Host, "ghe.example.com")
 	}
 	if !report.StartDate.Equal(startDate) {
 		t.Errorf("StartDate: got %v, want %v", report.StartDate, startDate)
@@ -228,12 +252,12 @@ func TestFetcher_Fetch_Error(t *testing.T) {
 		err:      errMock,
 	}
 
-	fetcher := NewFetcher(mock)
+	fetcher := NewFetcher(mock, WithLocation(timezone.JST))
 
 	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
 	endDate := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
 
-	_, err := fetcher.Fetch("test-org", "testuser", startDate, endDate)
+	_, err := fetcher.Fetch([]string{"test-org"}, "testuser", startDate, endDate)
 	if err == nil {
 		t.Error("Fetch() should return error")
 	}
@@ -246,3 +270,341 @@ type mockError struct{}
 func (e *mockError) Error() string {
 	return "mock error"
 }
+
+// funcPRSearcher は関数でSearchPRsの挙動を差し替えられるPRSearcher実装
+type funcPRSearcher struct {
+	search func(org, query, dateFilter string) ([]github.PullRequest, error)
+}
+
+func (f *funcPRSearcher) Username() string { return "testuser" }
+func (f *funcPRSearcher) Host() string     { return "github.com" }
+func (f *funcPRSearcher) SearchPRs(org, query, dateFilter string) ([]github.PullRequest, error) {
+	return f.search(org, query, dateFilter)
+}
+func (f *funcPRSearcher) SearchReviewedPRs(org, reviewer, dateFilter string) ([]github.PullRequest, error) {
+	return f.search(org, "is:pr reviewed-by:"+reviewer+" -author:"+reviewer, dateFilter)
+}
+
+func TestFetcher_Fetch_TruncatedSearch(t *testing.T) {
+	mergedAt := time.Date(2025, 1, 10, 12, 0, 0, 0, timezone.JST)
+	searcher := &funcPRSearcher{search: func(_, query, _ string) ([]github.PullRequest, error) {
+		if strings.Contains(query, "is:merged") {
+			return []github.PullRequest{
+				{Title: "Merged PR", URL: "https://github.com/test/repo/pull/1", MergedAt: &mergedAt},
+			}, fmt.Errorf("%w: is:pr merged:...", apperrors.ErrSearchTruncated)
+		}
+		return nil, nil
+	}}
+
+	fetcher := NewFetcher(searcher, WithLocation(timezone.JST))
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST)
+	endDate := time.Date(2025, 1, 31, 0, 0, 0, 0, timezone.JST)
+
+	report, err := fetcher.Fetch([]string{"test-org"}, "testuser", startDate, endDate)
+	if err != nil {
+		t.Fatalf("Fetch() should keep partial results, got error: %v", err)
+	}
+	if len(report.Warnings) != 1 {
+		t.Fatalf("len(Warnings): got %d, want 1", len(report.Warnings))
+	}
+	if !strings.Contains(report.Warnings[0], "truncated") {
+		t.Errorf("warning should mention truncation, got %q", report.Warnings[0])
+	}
+	if len(report.Days) != 1 || len(report.Days[0].Merged) != 1 {
+		t.Errorf("partial results should be kept, got %+v", report.Days)
+	}
+}
+
+func TestFetcher_Fetch_ReviewAttribution(t *testing.T) {
+	inWindow1 := time.Date(2025, 1, 10, 10, 0, 0, 0, timezone.JST)
+	inWindow2 := time.Date(2025, 1, 10, 18, 0, 0, 0, timezone.JST)
+	inWindow3 := time.Date(2025, 1, 12, 9, 0, 0, 0, timezone.JST)
+	outOfWindow := time.Date(2024, 12, 20, 9, 0, 0, 0, timezone.JST)
+
+	mock := &MockPRSearcher{
+		username: "testuser",
+		reviewPRs: []github.PullRequest{
+			{
+				Title: "Reviewed twice on two days",
+				URL:   "https://github.com/test/repo/pull/1",
+				// 最近pushされたが、レビューは期間内
+				UpdatedAt: time.Date(2025, 2, 5, 0, 0, 0, 0, timezone.JST),
+				Reviews: []github.Review{
+					{State: github.ReviewCommented, SubmittedAt: outOfWindow},
+					{State: github.ReviewCommented, SubmittedAt: inWindow1},
+					{State: github.ReviewChangesRequested, SubmittedAt: inWindow2},
+					{State: github.ReviewApproved, SubmittedAt: inWindow3},
+				},
+			},
+			{
+				Title:     "Reviewed before the window",
+				URL:       "https://github.com/test/repo/pull/2",
+				UpdatedAt: time.Date(2025, 1, 15, 0, 0, 0, 0, timezone.JST),
+				Reviews: []github.Review{
+					{State: github.ReviewApproved, SubmittedAt: outOfWindow},
+				},
+			},
+		},
+	}
+
+	fetcher := NewFetcher(mock, WithLocation(timezone.JST))
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST)
+	endDate := time.Date(2025, 1, 31, 0, 0, 0, 0, timezone.JST)
+
+	report, err := fetcher.Fetch([]string{"test-org"}, "testuser", startDate, endDate)
+	if err != nil {
+		t.Fatalf("Fetch() failed: %v", err)
+	}
+
+	reviewedByDay := make(map[string][]github.PullRequest)
+	for _, day := range report.Days {
+		if len(day.Reviewed) > 0 {
+			reviewedByDay[day.Date.Format("2006-01-02")] = day.Reviewed
+		}
+	}
+
+	if len(reviewedByDay) != 2 {
+		t.Fatalf("reviewed days: got %v, want 2025-01-10 and 2025-01-12", reviewedByDay)
+	}
+	day10 := reviewedByDay["2025-01-10"]
+	if len(day10) != 1 {
+		t.Fatalf("2025-01-10 reviewed: got %d PRs, want 1", len(day10))
+	}
+	if len(day10[0].Reviews) != 2 {
+		t.Errorf("2025-01-10 reviews: got %d, want 2 (only that day's reviews)", len(day10[0].Reviews))
+	}
+	day12 := reviewedByDay["2025-01-12"]
+	if len(day12) != 1 || day12[0].Reviews[0].State != github.ReviewApproved {
+		t.Errorf("2025-01-12 reviewed: got %+v, want the approving review", day12)
+	}
+}
+
+func TestSplitByReviewDay_NoReviews(t *testing.T) {
+	p := github.PullRequest{Title: "No reviews"}
+	got := splitByReviewDay(p, timezone.JST)
+	if len(got) != 1 || got[0].Title != "No reviews" {
+		t.Errorf("splitByReviewDay: got %+v, want the PR unchanged", got)
+	}
+}
+
+func TestFetcher_Fetch_Closed(t *testing.T) {
+	closedAt := time.Date(2025, 1, 20, 16, 0, 0, 0, timezone.JST)
+	mock := &MockPRSearcher{
+		username: "testuser",
+		closedPRs: []github.PullRequest{
+			{
+				Title:     "Abandoned experiment",
+				URL:       "https://github.com/test/repo/pull/9",
+				State:     "closed",
+				CreatedAt: time.Date(2025, 1, 5, 10, 0, 0, 0, timezone.JST),
+				ClosedAt:  &closedAt,
+			},
+		},
+	}
+
+	fetcher := NewFetcher(mock, WithLocation(timezone.JST))
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST)
+	endDate := time.Date(2025, 1, 31, 0, 0, 0, 0, timezone.JST)
+
+	report, err := fetcher.Fetch([]string{"test-org"}, "testuser", startDate, endDate)
+	if err != nil {
+		t.Fatalf("Fetch() failed: %v", err)
+	}
+
+	if len(report.Days) != 1 {
+		t.Fatalf("len(Days): got %d, want 1", len(report.Days))
+	}
+	day := report.Days[0]
+	// クローズした日にグループ化される
+	if day.Date.Day() != 20 {
+		t.Errorf("closed PR should be grouped by closedAt, got day %d", day.Date.Day())
+	}
+	if len(day.Closed) != 1 {
+		t.Errorf("len(Closed): got %d, want 1", len(day.Closed))
+	}
+}
+
+func TestFetcher_Fetch_OpenedRegardlessOfState(t *testing.T) {
+	createdAt := time.Date(2025, 1, 10, 10, 0, 0, 0, timezone.JST)
+	mergedAt := time.Date(2025, 1, 12, 10, 0, 0, 0, timezone.JST)
+
+	var openedQuery string
+	searcher := &funcPRSearcher{search: func(_, query, dateFilter string) ([]github.PullRequest, error) {
+		if !strings.HasPrefix(dateFilter, "created:") {
+			return nil, nil
+		}
+		openedQuery = query
+		return []github.PullRequest{
+			{Title: "Open PR", URL: "https://github.com/test/repo/pull/1", State: "open", CreatedAt: createdAt},
+			{Title: "Merged PR", URL: "https://github.com/test/repo/pull/2", State: "merged", CreatedAt: createdAt, MergedAt: &mergedAt},
+		}, nil
+	}}
+
+	fetcher := NewFetcher(searcher, WithLocation(timezone.JST))
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST)
+	endDate := time.Date(2025, 1, 31, 0, 0, 0, 0, timezone.JST)
+
+	report, err := fetcher.Fetch([]string{"test-org"}, "testuser", startDate, endDate)
+	if err != nil {
+		t.Fatalf("Fetch() failed: %v", err)
+	}
+
+	if strings.Contains(openedQuery, "is:open") {
+		t.Errorf("opened query should not filter by current state, got %q", openedQuery)
+	}
+	if len(report.Days) != 1 || len(report.Days[0].Opened) != 2 {
+		t.Fatalf("Opened: got %+v, want 2 PRs on 2025-01-10", report.Days)
+	}
+}
+
+func TestGroupByDate_DraftMode(t *testing.T) {
+	createdAt := time.Date(2025, 1, 10, 10, 0, 0, 0, timezone.JST)
+	opened := []github.PullRequest{
+		// Draftで作成され、その後Ready for reviewになった
+		{Title: "Ready later", CreatedAt: createdAt, CreatedAsDraft: true},
+		// 作成後にDraftへ戻された
+		{Title: "Converted to draft", CreatedAt: createdAt, IsDraft: true},
+	}
+
+	tests := []struct {
+		mode      DraftMode
+		wantDraft string
+		wantOpen  string
+	}{
+		{DraftAtCreation, "Ready later", "Converted to draft"},
+		{DraftAtFetch, "Converted to draft", "Ready later"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.mode), func(t *testing.T) {
+			days := groupByDate(timezone.JST, tt.mode, opened, nil, nil, nil)
+			if len(days) != 1 {
+				t.Fatalf("len(days): got %d, want 1", len(days))
+			}
+			day := days[0]
+			if len(day.Draft) != 1 || day.Draft[0].Title != tt.wantDraft {
+				t.Errorf("Draft: got %+v, want %q", day.Draft, tt.wantDraft)
+			}
+			if len(day.Opened) != 1 || day.Opened[0].Title != tt.wantOpen {
+				t.Errorf("Opened: got %+v, want %q", day.Opened, tt.wantOpen)
+			}
+		})
+	}
+}
+
+func TestNewFetcher_DraftMode(t *testing.T) {
+	mock := &MockPRSearcher{username: "testuser"}
+	if got := NewFetcher(mock).draftMode; got != DraftAtCreation {
+		t.Errorf("default draftMode: got %q, want %q", got, DraftAtCreation)
+	}
+	if got := NewFetcher(mock, WithDraftMode(DraftAtFetch)).draftMode; got != DraftAtFetch {
+		t.Errorf("draftMode: got %q, want %q", got, DraftAtFetch)
+	}
+}
+
+func TestFetcher_Fetch_MultipleOrgs(t *testing.T) {
+	createdAt := time.Date(2025, 1, 10, 10, 0, 0, 0, timezone.JST)
+
+	var searchedOrgs []string
+	searcher := &funcPRSearcher{search: func(org, _, dateFilter string) ([]github.PullRequest, error) {
+		if !strings.HasPrefix(dateFilter, "created:") {
+			return nil, nil
+		}
+		searchedOrgs = append(searchedOrgs, org)
+		return []github.PullRequest{
+			{Title: "PR in " + org, URL: "https://github.com/" + org + "/app/pull/1", Repository: org + "/app", CreatedAt: createdAt},
+		}, nil
+	}}
+
+	fetcher := NewFetcher(searcher, WithLocation(timezone.JST))
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST)
+	endDate := time.Date(2025, 1, 31, 0, 0, 0, 0, timezone.JST)
+
+	report, err := fetcher.Fetch([]string{"org-a", "org-b"}, "testuser", startDate, endDate)
+	if err != nil {
+		t.Fatalf("Fetch() failed: %v", err)
+	}
+
+	if strings.Join(searchedOrgs, ",") != "org-a,org-b" {
+		t.Errorf("searched orgs: got %v, want [org-a org-b]", searchedOrgs)
+	}
+	if len(report.Orgs) != 2 {
+		t.Errorf("Orgs: got %q, want 2 orgs", report.Orgs)
+	}
+	if len(report.Days) != 1 || len(report.Days[0].Opened) != 2 {
+		t.Fatalf("Opened: got %+v, want PRs from both orgs merged into one day", report.Days)
+	}
+	if report.Days[0].Opened[0].Repository == report.Days[0].Opened[1].Repository {
+		t.Error("same-named repositories in different orgs should stay distinct")
+	}
+}
+
+func TestFetcher_Fetch_NoOrgs(t *testing.T) {
+	fetcher := NewFetcher(&MockPRSearcher{username: "testuser"})
+	_, err := fetcher.Fetch(nil, "testuser", time.Now(), time.Now())
+	if !errors.Is(err, apperrors.ErrOrgRequired) {
+		t.Errorf("error: got %v, want ErrOrgRequired", err)
+	}
+}
+
+func TestFetcher_Fetch_Location(t *testing.T) {
+	berlin, err := timezone.Load("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	// ベルリンの 2025-01-10 23:30 (UTC 22:30) は JST では 2025-01-11 になる
+	createdAt := time.Date(2025, 1, 10, 22, 30, 0, 0, time.UTC)
+
+	var createdFilter string
+	searcher := &funcPRSearcher{search: func(_, _, dateFilter string) ([]github.PullRequest, error) {
+		if !strings.HasPrefix(dateFilter, "created:") {
+			return nil, nil
+		}
+		createdFilter = dateFilter
+		return []github.PullRequest{{Title: "Late PR", URL: "https://github.com/test/repo/pull/1", CreatedAt: createdAt}}, nil
+	}}
+
+	fetcher := NewFetcher(searcher, WithLocation(berlin))
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, berlin)
+	endDate := time.Date(2025, 1, 31, 0, 0, 0, 0, berlin)
+
+	report, err := fetcher.Fetch([]string{"test-org"}, "testuser", startDate, endDate)
+	if err != nil {
+		t.Fatalf("Fetch() failed: %v", err)
+	}
+
+	if want := "created:2025-01-01T00:00:00+01:00..2025-01-31T23:59:59+01:00"; createdFilter != want {
+		t.Errorf("date filter: got %q, want %q", createdFilter, want)
+	}
+	if len(report.Days) != 1 || report.Days[0].Date.Format("2006-01-02") != "2025-01-10" {
+		t.Fatalf("Days: got %+v, want the PR on 2025-01-10 (Berlin)", report.Days)
+	}
+	if report.Days[0].Date.Location() != berlin {
+		t.Errorf("Date location: got %v, want %v", report.Days[0].Date.Location(), berlin)
+	}
+	if report.Timezone != "Europe/Berlin" {
+		t.Errorf("Timezone: got %q, want %q", report.Timezone, "Europe/Berlin")
+	}
+}
+
+func TestFetcher_Fetch_Calendar(t *testing.T) {
+	searcher := &funcPRSearcher{search: func(_, _, _ string) ([]github.PullRequest, error) { return nil, nil }}
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST)
+
+	report, err := NewFetcher(searcher).Fetch([]string{"test-org"}, "testuser", start, start)
+	if err != nil {
+		t.Fatalf("Fetch() failed: %v", err)
+	}
+	if report.Calendar != nil {
+		t.Errorf("Calendar: got %+v, want nil by default", report.Calendar)
+	}
+
+	cal := &period.Calendar{WeekStart: time.Sunday}
+	report, err = NewFetcher(searcher, WithCalendar(cal)).Fetch([]string{"test-org"}, "testuser", start, start)
+	if err != nil {
+		t.Fatalf("Fetch() failed: %v", err)
+	}
+	if report.Calendar != cal {
+		t.Errorf("Calendar: got %+v, want %+v", report.Calendar, cal)
+	}
+}
@@ -0,0 +1,70 @@
+package pr
+
+import (
+	"path"
+	"strings"
+
+	"github.com/taikicoco/shiraberu/internal/github"
+)
+
+// LabelFilter はラベルによるPRの絞り込み条件。
+// パターンには path.Match の書式（例: area/*）を使用できる
+type LabelFilter struct {
+	// Include のいずれかに一致するラベルを持つPRのみ対象にする（空の場合は全て）
+	Include []string `json:"include,omitempty"`
+	// Exclude のいずれかに一致するラベルを持つPRは対象外にする
+	Exclude []string `json:"exclude,omitempty"`
+}
+
+// IsEmpty は絞り込み条件が指定されていないかどうかを返す
+func (f LabelFilter) IsEmpty() bool {
+	return len(f.Include) == 0 && len(f.Exclude) == 0
+}
+
+// String は絞り込み条件を表示用の文字列にする (例: "type/bug, area/* (excluding wip)")
+func (f LabelFilter) String() string {
+	var parts []string
+	if len(f.Include) > 0 {
+		parts = append(parts, strings.Join(f.Include, ", "))
+	}
+	if len(f.Exclude) > 0 {
+		parts = append(parts, "(excluding "+strings.Join(f.Exclude, ", ")+")")
+	}
+	return strings.Join(parts, " ")
+}
+
+// Match はPRが絞り込み条件を満たすかどうかを返す
+func (f LabelFilter) Match(pr github.PullRequest) bool {
+	if hasMatchingLabel(pr.Labels, f.Exclude) {
+		return false
+	}
+	if len(f.Include) == 0 {
+		return true
+	}
+	return hasMatchingLabel(pr.Labels, f.Include)
+}
+
+// Apply は条件を満たすPRのみを返す
+func (f LabelFilter) Apply(prs []github.PullRequest) []github.PullRequest {
+	if f.IsEmpty() {
+		return prs
+	}
+	result := make([]github.PullRequest, 0, len(prs))
+	for _, p := range prs {
+		if f.Match(p) {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+func hasMatchingLabel(labels, patterns []string) bool {
+	for _, label := range labels {
+		for _, pattern := range patterns {
+			if ok, err := path.Match(pattern, label); err == nil && ok {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+package pr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/taikicoco/shiraberu/internal/github"
+	"github.com/taikicoco/shiraberu/internal/timezone"
+)
+
+func TestLabelFilter_Match(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter LabelFilter
+		labels []string
+		want   bool
+	}{
+		{"empty filter", LabelFilter{}, nil, true},
+		{"include match", LabelFilter{Include: []string{"type/bug"}}, []string{"type/bug", "area/api"}, true},
+		{"include no match", LabelFilter{Include: []string{"type/bug"}}, []string{"type/feature"}, false},
+		{"include without labels", LabelFilter{Include: []string{"type/bug"}}, nil, false},
+		{"include glob", LabelFilter{Include: []string{"area/*"}}, []string{"area/web"}, true},
+		{"exclude match", LabelFilter{Exclude: []string{"wip"}}, []string{"type/bug", "wip"}, false},
+		{"exclude no match", LabelFilter{Exclude: []string{"wip"}}, []string{"type/bug"}, true},
+		{"exclude wins over include", LabelFilter{Include: []string{"type/*"}, Exclude: []string{"wip"}}, []string{"type/bug", "wip"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.filter.Match(github.PullRequest{Labels: tt.labels})
+			if got != tt.want {
+				t.Errorf("Match(%v): got %v, want %v", tt.labels, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLabelFilter_String(t *testing.T) {
+	tests := []struct {
+		filter LabelFilter
+		want   string
+	}{
+		{LabelFilter{}, ""},
+		{LabelFilter{Include: []string{"type/bug", "area/*"}}, "type/bug, area/*"},
+		{LabelFilter{Exclude: []string{"wip"}}, "(excluding wip)"},
+		{LabelFilter{Include: []string{"type/bug"}, Exclude: []string{"wip", "dependencies"}}, "type/bug (excluding wip, dependencies)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.filter.String(); got != tt.want {
+				t.Errorf("String(): got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFetcher_Fetch_LabelFilter(t *testing.T) {
+	createdAt := time.Date(2025, 1, 10, 10, 0, 0, 0, timezone.JST)
+	mergedAt := time.Date(2025, 1, 11, 10, 0, 0, 0, timezone.JST)
+	mock := &MockPRSearcher{
+		username: "testuser",
+		openedPRs: []github.PullRequest{
+			{Title: "Bug fix", URL: "https://github.com/test/repo/pull/1", CreatedAt: createdAt, Labels: []string{"type/bug"}},
+			{Title: "Feature", URL: "https://github.com/test/repo/pull/2", CreatedAt: createdAt, Labels: []string{"type/feature"}},
+			{Title: "Unlabeled", URL: "https://github.com/test/repo/pull/3", CreatedAt: createdAt},
+		},
+		mergedPRs: []github.PullRequest{
+			{Title: "WIP bug fix", URL: "https://github.com/test/repo/pull/4", MergedAt: &mergedAt, Labels: []string{"type/bug", "wip"}},
+		},
+	}
+
+	filter := LabelFilter{Include: []string{"type/bug"}, Exclude: []string{"wip"}}
+	fetcher := NewFetcher(mock, WithLabelFilter(filter), WithLocation(timezone.JST))
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST)
+	endDate := time.Date(2025, 1, 31, 0, 0, 0, 0, timezone.JST)
+
+	report, err := fetcher.Fetch([]string{"test-org"}, "testuser", startDate, endDate)
+	if err != nil {
+		t.Fatalf("Fetch() failed: %v", err)
+	}
+
+	if len(report.Days) != 1 {
+		t.Fatalf("len(Days): got %d, want 1", len(report.Days))
+	}
+	day := report.Days[0]
+	if len(day.Opened) != 1 || day.Opened[0].Title != "Bug fix" {
+		t.Errorf("Opened: got %+v, want only \"Bug fix\"", day.Opened)
+	}
+	if len(day.Merged) != 0 {
+		t.Errorf("Merged: got %+v, want excluded", day.Merged)
+	}
+	if len(report.LabelFilter.Include) != 1 || len(report.LabelFilter.Exclude) != 1 {
+		t.Errorf("LabelFilter: got %+v, want the applied filter", report.LabelFilter)
+	}
+}
@@ -0,0 +1,190 @@
+package prompt
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/taikicoco/shiraberu/internal/config"
+	apperrors "github.com/taikicoco/shiraberu/internal/errors"
+	"github.com/taikicoco/shiraberu/internal/period"
+)
+
+// stdoutPath は標準出力への出力を表す出力パス
+const stdoutPath = "-"
+
+var formats = []string{"browser", "html", "markdown", "json", "csv", "tsv", "slack", "slack-mrkdwn"}
+
+// Flags はコマンドラインフラグで指定された値。空文字は未指定を表す
+type Flags struct {
+	Org      string // カンマ区切りで複数指定可
+	Username string
+	From     string // YYYY-MM-DD
+	To       string // YYYY-MM-DD（省略時は今日）
+	Period   string // period.Presets のいずれか、または period.Parse が受け付ける期間式
+	Format   string
+	Output   string // "-" の場合は標準出力
+}
+
+// Apply はフラグで指定された値をプロンプトのデフォルト値として設定に反映する
+func (f Flags) Apply(cfg *config.Config) {
+	if orgs := config.SplitList(f.Org); len(orgs) > 0 {
+		cfg.Orgs = orgs
+	}
+	if f.Format != "" {
+		cfg.Format = f.Format
+	}
+}
+
+// RunWithFlags は標準入出力を使用して、フラグの値をデフォルトとしたプロンプトを実行する
+func RunWithFlags(cfg *config.Config, defaultUsername string, f Flags) (*Options, error) {
+	return NewRunner(NewDefaultIO()).RunWithFlags(cfg, defaultUsername, f)
+}
+
+// RunWithFlags はフラグの値をデフォルトとしてプロンプトを実行する。
+// プロンプトで尋ねない --output は、指定されていればプロンプトの結果の出力先より優先する
+func (r *Runner) RunWithFlags(cfg *config.Config, defaultUsername string, f Flags) (*Options, error) {
+	f.Apply(cfg)
+	if f.Username != "" {
+		defaultUsername = f.Username
+	}
+
+	opts, err := r.Run(cfg, defaultUsername)
+	if err != nil {
+		return nil, err
+	}
+	if f.Output != "" {
+		resolveOutputPath(opts, cfg, f)
+	}
+	return opts, nil
+}
+
+// Resolve はプロンプトを使わずにフラグと設定の値から Options を組み立てる。
+// 必須の値（Organization と期間）が不足している場合は ErrMissingOptions を返す
+func Resolve(cfg *config.Config, defaultUsername string, f Flags, now time.Time) (*Options, error) {
+	opts := &Options{
+		Orgs:     config.SplitList(f.Org),
+		Username: f.Username,
+		Format:   f.Format,
+	}
+	if len(opts.Orgs) == 0 {
+		opts.Orgs = cfg.Orgs
+	}
+	if opts.Username == "" {
+		opts.Username = defaultUsername
+	}
+	if err := resolveFormat(opts, cfg); err != nil {
+		return nil, err
+	}
+
+	if err := resolvePeriod(opts, f, cfg.Calendar, now); err != nil {
+		return nil, err
+	}
+
+	var missing []string
+	if len(opts.Orgs) == 0 {
+		missing = append(missing, "--org (or SHIRABERU_ORG)")
+	}
+	if opts.StartDate.IsZero() {
+		missing = append(missing, "--period or --from/--to")
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%w: %s", apperrors.ErrMissingOptions, strings.Join(missing, ", "))
+	}
+
+	resolveOutputPath(opts, cfg, f)
+	return opts, nil
+}
+
+// ResolveOutput は取得済みのレポート（スナップショット）を出力する場合の Options を組み立てる。
+// 期間はレポートの start〜end を使用するため、Organization・ユーザー・期間のフラグとは併用できない
+func ResolveOutput(cfg *config.Config, f Flags, start, end time.Time) (*Options, error) {
+	if f.Org != "" || f.Username != "" || f.Period != "" || f.From != "" || f.To != "" {
+		return nil, fmt.Errorf("%w: --org, --user, --period, --from and --to cannot be used with a snapshot", apperrors.ErrInvalidOption)
+	}
+
+	opts := &Options{
+		StartDate:  start,
+		EndDate:    end,
+		PeriodType: period.TypeCustom,
+		Format:     f.Format,
+	}
+	if err := resolveFormat(opts, cfg); err != nil {
+		return nil, err
+	}
+	resolveOutputPath(opts, cfg, f)
+	return opts, nil
+}
+
+// resolveFormat はフラグで指定された出力形式を検証する。指定されていない場合は設定の値を使用する
+func resolveFormat(opts *Options, cfg *config.Config) error {
+	if opts.Format == "" {
+		opts.Format = cfg.Format
+	} else if !slices.Contains(formats, opts.Format) {
+		return fmt.Errorf("%w: --format must be one of %s, got %q", apperrors.ErrInvalidOption, strings.Join(formats, ", "), opts.Format)
+	}
+	return nil
+}
+
+// resolveOutputPath は --output と出力ディレクトリから出力先を決定する
+func resolveOutputPath(opts *Options, cfg *config.Config, f Flags) {
+	switch f.Output {
+	case stdoutPath:
+		opts.OutputPath = ""
+	case "":
+		opts.OutputPath = defaultOutputPath(cfg, opts)
+	default:
+		opts.OutputPath = f.Output
+	}
+}
+
+// resolvePeriod はフラグから期間を決定する。期間が指定されていない場合は何もしない
+func resolvePeriod(opts *Options, f Flags, cal *period.Calendar, now time.Time) error {
+	if f.Period != "" {
+		if f.From != "" || f.To != "" {
+			return fmt.Errorf("%w: --period cannot be combined with --from/--to", apperrors.ErrInvalidOption)
+		}
+		start, end, periodType, err := cal.Parse(f.Period, now)
+		if err != nil {
+			return fmt.Errorf("%w: --period: %w", apperrors.ErrInvalidOption, err)
+		}
+		opts.StartDate, opts.EndDate, opts.PeriodType = start, end, periodType
+		return nil
+	}
+
+	if f.From == "" {
+		if f.To != "" {
+			return fmt.Errorf("%w: --to requires --from", apperrors.ErrInvalidOption)
+		}
+		return nil
+	}
+
+	start, err := time.ParseInLocation("2006-01-02", f.From, now.Location())
+	if err != nil {
+		return fmt.Errorf("%w: --from %q (want YYYY-MM-DD)", apperrors.ErrInvalidDate, f.From)
+	}
+	end := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	if f.To != "" {
+		end, err = time.ParseInLocation("2006-01-02", f.To, now.Location())
+		if err != nil {
+			return fmt.Errorf("%w: --to %q (want YYYY-MM-DD)", apperrors.ErrInvalidDate, f.To)
+		}
+	}
+	if end.Before(start) {
+		return fmt.Errorf("%w: end date %s is before --from %s", apperrors.ErrInvalidDate, end.Format("2006-01-02"), f.From)
+	}
+
+	opts.StartDate, opts.EndDate, opts.PeriodType = start, end, period.TypeCustom
+	return nil
+}
+
+// IsTerminal は標準入力が端末（対話的に入力できる状態）かどうかを返す
+func IsTerminal() bool {
+	fi, err := os.Stdin.Stat()
+	if err != nil {
+		return false
+	}
+	return fi.Mode()&os.ModeCharDevice != 0
+}
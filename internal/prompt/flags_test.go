@@ -0,0 +1,283 @@
+package prompt
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/taikicoco/shiraberu/internal/config"
+	apperrors "github.com/taikicoco/shiraberu/internal/errors"
+	"github.com/taikicoco/shiraberu/internal/period"
+)
+
+var flagsNow = time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC) // Wednesday
+
+func TestResolve_Period(t *testing.T) {
+	cfg := &config.Config{Format: "markdown"}
+
+	opts, err := Resolve(cfg, "default-user", Flags{Org: "org-a,org-b,org-a", Period: "last-week"}, flagsNow)
+	if err != nil {
+		t.Fatalf("Resolve() failed: %v", err)
+	}
+
+	if len(opts.Orgs) != 2 || opts.Orgs[0] != "org-a" || opts.Orgs[1] != "org-b" {
+		t.Errorf("Orgs: got %q, want [org-a org-b]", opts.Orgs)
+	}
+	if opts.Username != "default-user" {
+		t.Errorf("Username: got %q, want %q", opts.Username, "default-user")
+	}
+	if opts.Format != "markdown" {
+		t.Errorf("Format: got %q, want config default %q", opts.Format, "markdown")
+	}
+	if !opts.StartDate.Equal(time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)) || !opts.EndDate.Equal(time.Date(2025, 1, 12, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("period: got %v - %v, want 2025-01-06 - 2025-01-12", opts.StartDate, opts.EndDate)
+	}
+	if opts.PeriodType != period.TypeWeek {
+		t.Errorf("PeriodType: got %q, want %q", opts.PeriodType, period.TypeWeek)
+	}
+}
+
+func TestResolve_Period_SundayStart(t *testing.T) {
+	cfg := &config.Config{Orgs: []string{"org"}, Calendar: &period.Calendar{WeekStart: time.Sunday}}
+
+	opts, err := Resolve(cfg, "user", Flags{Period: "this-week"}, flagsNow)
+	if err != nil {
+		t.Fatalf("Resolve() failed: %v", err)
+	}
+	if !opts.StartDate.Equal(time.Date(2025, 1, 12, 0, 0, 0, 0, time.UTC)) || !opts.EndDate.Equal(time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("period: got %v - %v, want 2025-01-12 - 2025-01-15", opts.StartDate, opts.EndDate)
+	}
+}
+
+func TestResolve_PeriodExpression(t *testing.T) {
+	cfg := &config.Config{Orgs: []string{"org"}}
+
+	tests := []struct {
+		expr      string
+		wantStart time.Time
+		wantEnd   time.Time
+		wantType  period.Type
+	}{
+		{"last-7d", time.Date(2025, 1, 9, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC), period.TypeCustom},
+		{"2024-Q4", time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), period.TypeQuarter},
+		{"since 2025-01-01", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC), period.TypeCustom},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			opts, err := Resolve(cfg, "user", Flags{Period: tt.expr}, flagsNow)
+			if err != nil {
+				t.Fatalf("Resolve() failed: %v", err)
+			}
+			if !opts.StartDate.Equal(tt.wantStart) || !opts.EndDate.Equal(tt.wantEnd) {
+				t.Errorf("period: got %v - %v, want %v - %v", opts.StartDate, opts.EndDate, tt.wantStart, tt.wantEnd)
+			}
+			if opts.PeriodType != tt.wantType {
+				t.Errorf("PeriodType: got %q, want %q", opts.PeriodType, tt.wantType)
+			}
+		})
+	}
+}
+
+func TestResolve_FromTo(t *testing.T) {
+	cfg := &config.Config{Orgs: []string{"env-org"}, Format: "browser", OutputDir: "/tmp/reports"}
+
+	tests := []struct {
+		name     string
+		flags    Flags
+		wantEnd  time.Time
+		wantPath string
+	}{
+		{
+			name:     "from and to",
+			flags:    Flags{From: "2025-01-01", To: "2025-01-07", Format: "html"},
+			wantEnd:  time.Date(2025, 1, 7, 0, 0, 0, 0, time.UTC),
+			wantPath: filepath.Join("/tmp/reports", "20250101-20250107.html"),
+		},
+		{
+			name:     "to defaults to today",
+			flags:    Flags{From: "2025-01-01", Format: "markdown", Output: "report.md"},
+			wantEnd:  time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC),
+			wantPath: "report.md",
+		},
+		{
+			name:     "json",
+			flags:    Flags{From: "2025-01-01", To: "2025-01-07", Format: "json"},
+			wantEnd:  time.Date(2025, 1, 7, 0, 0, 0, 0, time.UTC),
+			wantPath: filepath.Join("/tmp/reports", "20250101-20250107.json"),
+		},
+		{
+			name:     "tsv",
+			flags:    Flags{From: "2025-01-01", To: "2025-01-07", Format: "tsv"},
+			wantEnd:  time.Date(2025, 1, 7, 0, 0, 0, 0, time.UTC),
+			wantPath: filepath.Join("/tmp/reports", "20250101-20250107.tsv"),
+		},
+		{
+			name:     "slack",
+			flags:    Flags{From: "2025-01-01", To: "2025-01-07", Format: "slack"},
+			wantEnd:  time.Date(2025, 1, 7, 0, 0, 0, 0, time.UTC),
+			wantPath: filepath.Join("/tmp/reports", "20250101-20250107.slack.json"),
+		},
+		{
+			name:     "slack mrkdwn",
+			flags:    Flags{From: "2025-01-01", To: "2025-01-07", Format: "slack-mrkdwn"},
+			wantEnd:  time.Date(2025, 1, 7, 0, 0, 0, 0, time.UTC),
+			wantPath: filepath.Join("/tmp/reports", "20250101-20250107.slack.txt"),
+		},
+		{
+			name:     "stdout",
+			flags:    Flags{From: "2025-01-01", To: "2025-01-07", Format: "markdown", Output: "-"},
+			wantEnd:  time.Date(2025, 1, 7, 0, 0, 0, 0, time.UTC),
+			wantPath: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := Resolve(cfg, "default-user", tt.flags, flagsNow)
+			if err != nil {
+				t.Fatalf("Resolve() failed: %v", err)
+			}
+			if len(opts.Orgs) != 1 || opts.Orgs[0] != "env-org" {
+				t.Errorf("Orgs: got %q, want env value [env-org]", opts.Orgs)
+			}
+			if !opts.EndDate.Equal(tt.wantEnd) {
+				t.Errorf("EndDate: got %v, want %v", opts.EndDate, tt.wantEnd)
+			}
+			if opts.PeriodType != period.TypeCustom {
+				t.Errorf("PeriodType: got %q, want %q", opts.PeriodType, period.TypeCustom)
+			}
+			if opts.OutputPath != tt.wantPath {
+				t.Errorf("OutputPath: got %q, want %q", opts.OutputPath, tt.wantPath)
+			}
+		})
+	}
+}
+
+func TestResolve_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     config.Config
+		flags   Flags
+		wantErr error
+	}{
+		{"no org", config.Config{}, Flags{Period: "today"}, apperrors.ErrMissingOptions},
+		{"no period", config.Config{Orgs: []string{"org"}}, Flags{}, apperrors.ErrMissingOptions},
+		{"unknown period", config.Config{Orgs: []string{"org"}}, Flags{Period: "fortnight"}, apperrors.ErrInvalidOption},
+		{"unknown period is an invalid date", config.Config{Orgs: []string{"org"}}, Flags{Period: "fortnight"}, apperrors.ErrInvalidDate},
+		{"future period", config.Config{Orgs: []string{"org"}}, Flags{Period: "2025-02"}, apperrors.ErrInvalidDate},
+		{"period with from", config.Config{Orgs: []string{"org"}}, Flags{Period: "today", From: "2025-01-01"}, apperrors.ErrInvalidOption},
+		{"to without from", config.Config{Orgs: []string{"org"}}, Flags{To: "2025-01-01"}, apperrors.ErrInvalidOption},
+		{"bad from", config.Config{Orgs: []string{"org"}}, Flags{From: "01/01/2025"}, apperrors.ErrInvalidDate},
+		{"to before from", config.Config{Orgs: []string{"org"}}, Flags{From: "2025-01-07", To: "2025-01-01"}, apperrors.ErrInvalidDate},
+		{"unknown format", config.Config{Orgs: []string{"org"}}, Flags{Period: "today", Format: "pdf"}, apperrors.ErrInvalidOption},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Resolve(&tt.cfg, "default-user", tt.flags, flagsNow)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("error: got %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFlags_Apply(t *testing.T) {
+	cfg := &config.Config{Orgs: []string{"env-org"}, Format: "markdown"}
+	Flags{Org: "flag-org", Format: "html"}.Apply(cfg)
+
+	if len(cfg.Orgs) != 1 || cfg.Orgs[0] != "flag-org" {
+		t.Errorf("Orgs: got %q, want [flag-org]", cfg.Orgs)
+	}
+	if cfg.Format != "html" {
+		t.Errorf("Format: got %q, want %q", cfg.Format, "html")
+	}
+
+	cfg = &config.Config{Orgs: []string{"env-org"}, Format: "markdown"}
+	Flags{}.Apply(cfg)
+	if cfg.Orgs[0] != "env-org" || cfg.Format != "markdown" {
+		t.Errorf("empty flags should keep config values, got %+v", cfg)
+	}
+}
+
+func TestRunner_RunWithFlags_Output(t *testing.T) {
+	tests := []struct {
+		name     string
+		output   string
+		wantPath string
+	}{
+		{"stdout", "-", ""},
+		{"file", "report.md", "report.md"},
+		{"default", "", filepath.Join("/tmp/reports", "20250115.md")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{Format: "markdown", OutputDir: "/tmp/reports", Location: time.UTC}
+			f := Flags{Org: "flag-org", Format: "markdown", Output: tt.output}
+
+			// 期間が指定されていないため、プロンプトで補う必要がある
+			if _, err := Resolve(cfg, "user", f, flagsNow); !errors.Is(err, apperrors.ErrMissingOptions) {
+				t.Fatalf("Resolve: expected ErrMissingOptions, got %v", err)
+			}
+
+			mockIO := &MockIO{
+				readLineResponses: []string{
+					"flag-org", // Organization
+					"user",     // Username
+					"",         // confirmDateRange (Enter = OK)
+				},
+				selectResponses: []int{
+					0, // Period type: Single day
+					0, // Select date: Today
+					2, // Output format: Markdown
+				},
+			}
+			runner := NewRunner(mockIO)
+			runner.clock = func() time.Time { return flagsNow }
+			opts, err := runner.RunWithFlags(cfg, "user", f)
+			if err != nil {
+				t.Fatalf("RunWithFlags failed: %v", err)
+			}
+			if len(opts.Orgs) != 1 || opts.Orgs[0] != "flag-org" {
+				t.Errorf("Orgs: got %q, want [flag-org]", opts.Orgs)
+			}
+			if opts.OutputPath != tt.wantPath {
+				t.Errorf("OutputPath: got %q, want %q", opts.OutputPath, tt.wantPath)
+			}
+		})
+	}
+}
+
+func TestResolveOutput(t *testing.T) {
+	cfg := &config.Config{Orgs: []string{"env-org"}, Format: "markdown", OutputDir: "/tmp/reports"}
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	opts, err := ResolveOutput(cfg, Flags{Format: "html"}, start, end)
+	if err != nil {
+		t.Fatalf("ResolveOutput() failed: %v", err)
+	}
+	if opts.Format != "html" || !opts.StartDate.Equal(start) || !opts.EndDate.Equal(end) {
+		t.Errorf("opts: got %+v", opts)
+	}
+	if want := filepath.Join("/tmp/reports", "20250101-20250331.html"); opts.OutputPath != want {
+		t.Errorf("OutputPath: got %q, want %q", opts.OutputPath, want)
+	}
+
+	opts, err = ResolveOutput(cfg, Flags{Output: "-"}, start, end)
+	if err != nil {
+		t.Fatalf("ResolveOutput() failed: %v", err)
+	}
+	if opts.Format != "markdown" || opts.OutputPath != "" {
+		t.Errorf("opts: got format %q, path %q", opts.Format, opts.OutputPath)
+	}
+
+	for _, f := range []Flags{{Format: "pdf"}, {Period: "last-week"}, {Org: "org"}} {
+		if _, err := ResolveOutput(cfg, f, start, end); !errors.Is(err, apperrors.ErrInvalidOption) {
+			t.Errorf("ResolveOutput(%+v): got %v, want ErrInvalidOption", f, err)
+		}
+	}
+}
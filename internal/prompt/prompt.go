@@ -81,7 +81,10 @@ func (d *DefaultIO) Select(label string, options []string, defaultIdx int) (int,
 
 // Runner はプロンプトの実行を管理する
 type Runner struct {
-	io IO
+	io       IO
+	location *time.Location   // 日付の基準にするタイムゾーン（nil の場合はローカルタイムゾーン）
+	calendar *period.Calendar // 週の始まりなど期間計算の基準（nil の場合は月曜始まり）
+	clock    func() time.Time // 現在時刻を返す（nil の場合は time.Now。テストで固定するために使う）
 }
 
 // NewRunner は指定されたIOを使用するRunnerを作成する
@@ -90,7 +93,7 @@ func NewRunner(io IO) *Runner {
 }
 
 type Options struct {
-	Org        string
+	Orgs       []string
 	Username   string
 	StartDate  time.Time
 	EndDate    time.Time
@@ -119,14 +122,16 @@ func Run(cfg *config.Config, defaultUsername string) (*Options, error) {
 // Run はインタラクティブプロンプトを実行してオプションを収集する
 func (r *Runner) Run(cfg *config.Config, defaultUsername string) (*Options, error) {
 	opts := &Options{}
+	r.location = cfg.Location
+	r.calendar = cfg.Calendar
 
 	currentStep := stepOrg
 
 	for currentStep != stepDone {
 		switch currentStep {
 		case stepOrg:
-			opts.Org = r.promptText("Organization", cfg.Org)
-			if opts.Org == "" {
+			opts.Orgs = config.SplitList(r.promptText("Organization (comma separated for multiple)", strings.Join(cfg.Orgs, ",")))
+			if len(opts.Orgs) == 0 {
 				return nil, apperrors.ErrOrgRequired
 			}
 			currentStep = stepUsername
@@ -158,8 +163,8 @@ func (r *Runner) Run(cfg *config.Config, defaultUsername string) (*Options, erro
 			currentStep = stepFormat
 
 		case stepFormat:
-			formats := []string{"HTML (open in browser)", "HTML", "Markdown", backOption}
-			formatValues := []string{"browser", "html", "markdown"}
+			formats := []string{"HTML (open in browser)", "HTML", "Markdown", "JSON", "CSV", "TSV", "Slack (Block Kit JSON)", "Slack (mrkdwn)", backOption}
+			formatValues := []string{"browser", "html", "markdown", "json", "csv", "tsv", "slack", "slack-mrkdwn"}
 			defaultIdx := 0
 			for i, v := range formatValues {
 				if v == cfg.Format {
@@ -168,7 +173,7 @@ func (r *Runner) Run(cfg *config.Config, defaultUsername string) (*Options, erro
 				}
 			}
 			idx := r.promptSelect("Output format", formats, defaultIdx)
-			if idx == 3 { // Back
+			if idx == len(formatValues) { // Back
 				currentStep = stepPeriodMode
 				continue
 			}
@@ -177,19 +182,30 @@ func (r *Runner) Run(cfg *config.Config, defaultUsername string) (*Options, erro
 		}
 	}
 
-	// Output path (auto-generate if output_dir is set)
-	if opts.Format != "browser" && cfg.OutputDir != "" {
-		ext := ".md"
-		if opts.Format == "html" {
-			ext = ".html"
-		}
-		filename := generateFilename(opts.StartDate, opts.EndDate, ext)
-		opts.OutputPath = filepath.Join(cfg.OutputDir, filename)
-	}
+	opts.OutputPath = defaultOutputPath(cfg, opts)
 
 	return opts, nil
 }
 
+// defaultOutputPath は出力ディレクトリが設定されている場合に出力ファイルのパスを生成する
+func defaultOutputPath(cfg *config.Config, opts *Options) string {
+	if opts.Format == "browser" || cfg.OutputDir == "" {
+		return ""
+	}
+	ext := ".md"
+	switch opts.Format {
+	case "html":
+		ext = ".html"
+	case "json", "csv", "tsv":
+		ext = "." + opts.Format
+	case "slack":
+		ext = ".slack.json"
+	case "slack-mrkdwn":
+		ext = ".slack.txt"
+	}
+	return filepath.Join(cfg.OutputDir, generateFilename(opts.StartDate, opts.EndDate, ext))
+}
+
 func generateFilename(start, end time.Time, ext string) string {
 	if start.Equal(end) {
 		return start.Format("20060102") + ext
@@ -198,7 +214,7 @@ func generateFilename(start, end time.Time, ext string) string {
 }
 
 func (r *Runner) promptSingleDay() (time.Time, time.Time, bool) {
-	now := time.Now()
+	now := r.now()
 	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
 	yesterday := today.AddDate(0, 0, -1)
 
@@ -228,62 +244,67 @@ func (r *Runner) promptSingleDay() (time.Time, time.Time, bool) {
 }
 
 func (r *Runner) promptDateRange() (time.Time, time.Time, period.Type, bool) {
-	now := time.Now()
+	now := r.now()
 	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
 	weekdays := []string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
 
-	// Calculate this Monday
-	weekday := int(today.Weekday())
-	if weekday == 0 {
-		weekday = 7
+	// プリセットの選択肢（表示名と日付の書式）
+	type presetChoice struct {
+		name   string
+		label  string
+		format func(start, end time.Time) string
+	}
+	weekFormat := func(start, end time.Time) string {
+		return fmt.Sprintf("%s %s - %s %s", start.Format("1/2"), weekdays[start.Weekday()], end.Format("1/2"), weekdays[end.Weekday()])
+	}
+	monthFormat := func(start, end time.Time) string {
+		return fmt.Sprintf("%s - %s", start.Format("1/2"), end.Format("1/2"))
+	}
+	yearFormat := func(start, end time.Time) string {
+		return fmt.Sprintf("%s - %s", start.Format("2006/1/2"), end.Format("2006/1/2"))
+	}
+	choices := []presetChoice{
+		{period.PresetThisWeek, "This week", weekFormat},
+		{period.PresetLastWeek, "Last week", weekFormat},
+		{period.PresetThisMonth, "This month", monthFormat},
+		{period.PresetLastMonth, "Last month", monthFormat},
+		{period.PresetThisQuarter, "This quarter", yearFormat},
+		{period.PresetLastQuarter, "Last quarter", yearFormat},
+		{period.PresetThisFiscalYear, "This fiscal year", yearFormat},
+		{period.PresetLastFiscalYear, "Last fiscal year", yearFormat},
 	}
-	thisMonday := today.AddDate(0, 0, -(weekday - 1))
-	lastMonday := thisMonday.AddDate(0, 0, -7)
-	lastSunday := thisMonday.AddDate(0, 0, -1)
-
-	// First day of this month
-	thisMonthStart := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, today.Location())
-	// Last month
-	lastMonthStart := thisMonthStart.AddDate(0, -1, 0)
-	lastMonthEnd := thisMonthStart.AddDate(0, 0, -1)
 
-	options := []string{
-		fmt.Sprintf("This week (%s %s - %s %s)", thisMonday.Format("1/2"), weekdays[thisMonday.Weekday()], today.Format("1/2"), weekdays[today.Weekday()]),
-		fmt.Sprintf("Last week (%s %s - %s %s)", lastMonday.Format("1/2"), weekdays[lastMonday.Weekday()], lastSunday.Format("1/2"), weekdays[lastSunday.Weekday()]),
-		fmt.Sprintf("This month (%s - %s)", thisMonthStart.Format("1/2"), today.Format("1/2")),
-		fmt.Sprintf("Last month (%s - %s)", lastMonthStart.Format("1/2"), lastMonthEnd.Format("1/2")),
-		"Select month",
-		"Enter dates",
-		backOption,
+	options := make([]string, 0, len(choices)+3)
+	for _, c := range choices {
+		start, end, _, _ := r.calendar.Preset(c.name, now)
+		options = append(options, fmt.Sprintf("%s (%s)", c.label, c.format(start, end)))
 	}
+	selectMonthIdx := len(options)
+	enterDatesIdx := selectMonthIdx + 1
+	expressionIdx := selectMonthIdx + 2
+	backIdx := selectMonthIdx + 3
+	options = append(options, "Select month", "Enter dates", "Type a period (e.g. last-7d, 2025-Q3, since 2025-04-01)", backOption)
+
 	idx := r.promptSelect("Select range", options, 0)
 
-	if idx == 6 { // Back
+	if idx == backIdx {
 		return time.Time{}, time.Time{}, "", true
 	}
 
 	var start, end time.Time
 	var periodType period.Type
 	switch idx {
-	case 0: // This week
-		start, end = thisMonday, today
-		periodType = period.TypeWeek
-	case 1: // Last week
-		start, end = lastMonday, lastSunday
-		periodType = period.TypeWeek
-	case 2: // This month
-		start, end = thisMonthStart, today
-		periodType = period.TypeMonth
-	case 3: // Last month
-		start, end = lastMonthStart, lastMonthEnd
-		periodType = period.TypeMonth
-	case 4: // Select month
+	case selectMonthIdx:
 		start, end = r.promptSelectMonth(today)
 		periodType = period.TypeMonth
-	case 5: // Enter dates
+	case enterDatesIdx:
 		start = r.promptDate("Start date (YYYY-MM-DD)", today.AddDate(0, 0, -7))
 		end = r.promptDate("End date (YYYY-MM-DD)", today)
 		periodType = period.TypeCustom
+	case expressionIdx:
+		start, end, periodType = r.promptExpression(now)
+	default:
+		start, end, periodType, _ = r.calendar.Preset(choices[idx].name, now)
 	}
 
 	// Confirm
@@ -336,13 +357,43 @@ func (r *Runner) promptDate(label string, defaultDate time.Time) time.Time {
 	defaultStr := defaultDate.Format("2006-01-02")
 	input := r.promptText(label, defaultStr)
 
-	parsed, err := time.Parse("2006-01-02", input)
+	parsed, err := time.ParseInLocation("2006-01-02", input, r.now().Location())
 	if err != nil {
 		return defaultDate
 	}
 	return parsed
 }
 
+// promptExpression は期間式（period.Parse の形式）を入力させる。
+// 解釈できない場合はエラー内容を表示して再入力させる
+func (r *Runner) promptExpression(now time.Time) (time.Time, time.Time, period.Type) {
+	const defaultExpr = "last-7d"
+	label := "Period"
+	for {
+		input := strings.TrimSpace(r.promptText(label, defaultExpr))
+		if input == "" {
+			input = defaultExpr
+		}
+		start, end, periodType, err := r.calendar.Parse(input, now)
+		if err == nil {
+			return start, end, periodType
+		}
+		label = fmt.Sprintf("%v. Period", err)
+	}
+}
+
+// now は設定されたタイムゾーンでの現在時刻を返す
+func (r *Runner) now() time.Time {
+	now := time.Now
+	if r.clock != nil {
+		now = r.clock
+	}
+	if r.location == nil {
+		return now()
+	}
+	return now().In(r.location)
+}
+
 func (r *Runner) promptText(label string, defaultVal string) string {
 	input, err := r.io.ReadLine(label, defaultVal)
 	if err != nil {
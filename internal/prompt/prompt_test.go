@@ -2,6 +2,7 @@ package prompt
 
 import (
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -94,7 +95,7 @@ func TestGenerateFilename(t *testing.T) {
 
 func TestOptions_Struct(t *testing.T) {
 	opts := Options{
-		Org:        "test-org",
+		Orgs:       []string{"test-org"},
 		StartDate:  time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 		EndDate:    time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
 		PeriodType: period.TypeMonth,
@@ -102,8 +103,8 @@ func TestOptions_Struct(t *testing.T) {
 		OutputPath: "/path/to/output.html",
 	}
 
-	if opts.Org != "test-org" {
-		t.Errorf("Org: got %q, want %q", opts.Org, "test-org")
+	if len(opts.Orgs) != 1 || opts.Orgs[0] != "test-org" {
+		t.Errorf("Orgs: got %q, want [test-org]", opts.Orgs)
 	}
 	if opts.Format != "html" {
 		t.Errorf("Format: got %q, want %q", opts.Format, "html")
@@ -148,7 +149,7 @@ func TestRunner_Run_SingleDayBrowser(t *testing.T) {
 		},
 	}
 
-	cfg := &config.Config{Org: "default-org", Format: "browser"}
+	cfg := &config.Config{Orgs: []string{"default-org"}, Format: "browser"}
 	r := NewRunner(mockIO)
 
 	opts, err := r.Run(cfg, "default-user")
@@ -156,8 +157,8 @@ func TestRunner_Run_SingleDayBrowser(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
-	if opts.Org != "my-org" {
-		t.Errorf("Org: got %q, want %q", opts.Org, "my-org")
+	if len(opts.Orgs) != 1 || opts.Orgs[0] != "my-org" {
+		t.Errorf("Orgs: got %q, want [my-org]", opts.Orgs)
 	}
 	if opts.Username != "testuser" {
 		t.Errorf("Username: got %q, want %q", opts.Username, "testuser")
@@ -185,7 +186,7 @@ func TestRunner_Run_DateRangeMarkdown(t *testing.T) {
 		},
 	}
 
-	cfg := &config.Config{Org: "default-org", Format: "markdown"}
+	cfg := &config.Config{Orgs: []string{"default-org"}, Format: "markdown"}
 	r := NewRunner(mockIO)
 
 	opts, err := r.Run(cfg, "default-user")
@@ -193,8 +194,8 @@ func TestRunner_Run_DateRangeMarkdown(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
-	if opts.Org != "my-org" {
-		t.Errorf("Org: got %q, want %q", opts.Org, "my-org")
+	if len(opts.Orgs) != 1 || opts.Orgs[0] != "my-org" {
+		t.Errorf("Orgs: got %q, want [my-org]", opts.Orgs)
 	}
 	if opts.Format != "markdown" {
 		t.Errorf("Format: got %q, want %q", opts.Format, "markdown")
@@ -219,7 +220,7 @@ func TestRunner_Run_DefaultUsername(t *testing.T) {
 		},
 	}
 
-	cfg := &config.Config{Org: "default-org", Format: "browser"}
+	cfg := &config.Config{Orgs: []string{"default-org"}, Format: "browser"}
 	r := NewRunner(mockIO)
 
 	opts, err := r.Run(cfg, "default-user")
@@ -232,6 +233,33 @@ func TestRunner_Run_DefaultUsername(t *testing.T) {
 	}
 }
 
+func TestRunner_Run_MultipleOrgs(t *testing.T) {
+	mockIO := &MockIO{
+		readLineResponses: []string{
+			"org-a, org-b", // Organization
+			"testuser",     // Username
+			"",             // confirmDateRange (Enter = OK)
+		},
+		selectResponses: []int{
+			0, // Period type: Single day
+			0, // Select date: Today
+			0, // Output format: browser
+		},
+	}
+
+	cfg := &config.Config{Orgs: []string{"default-org"}, Format: "browser"}
+	r := NewRunner(mockIO)
+
+	opts, err := r.Run(cfg, "default-user")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(opts.Orgs) != 2 || opts.Orgs[0] != "org-a" || opts.Orgs[1] != "org-b" {
+		t.Errorf("Orgs: got %q, want [org-a org-b]", opts.Orgs)
+	}
+}
+
 func TestRunner_Run_EmptyOrgError(t *testing.T) {
 	// When org is empty and no default, should return error
 	mockIO := &MockIO{
@@ -240,7 +268,7 @@ func TestRunner_Run_EmptyOrgError(t *testing.T) {
 		},
 	}
 
-	cfg := &config.Config{Org: "", Format: "browser"}
+	cfg := &config.Config{Orgs: nil, Format: "browser"}
 	r := NewRunner(mockIO)
 
 	_, err := r.Run(cfg, "default-user")
@@ -264,7 +292,7 @@ func TestRunner_Run_OutputPathHTML(t *testing.T) {
 		},
 	}
 
-	cfg := &config.Config{Org: "default-org", Format: "html", OutputDir: "/tmp/reports"}
+	cfg := &config.Config{Orgs: []string{"default-org"}, Format: "html", OutputDir: "/tmp/reports"}
 	r := NewRunner(mockIO)
 
 	opts, err := r.Run(cfg, "default-user")
@@ -294,7 +322,7 @@ func TestRunner_Run_OutputPathMarkdown(t *testing.T) {
 		},
 	}
 
-	cfg := &config.Config{Org: "default-org", Format: "markdown", OutputDir: "/tmp/reports"}
+	cfg := &config.Config{Orgs: []string{"default-org"}, Format: "markdown", OutputDir: "/tmp/reports"}
 	r := NewRunner(mockIO)
 
 	opts, err := r.Run(cfg, "default-user")
@@ -310,6 +338,36 @@ func TestRunner_Run_OutputPathMarkdown(t *testing.T) {
 	}
 }
 
+func TestRunner_Run_OutputPathJSON(t *testing.T) {
+	mockIO := &MockIO{
+		readLineResponses: []string{
+			"my-org",   // Organization
+			"testuser", // Username
+			"",         // confirmDateRange (Enter = OK)
+		},
+		selectResponses: []int{
+			0, // Period type: Single day
+			0, // Select date: Today
+			3, // Output format: JSON
+		},
+	}
+
+	cfg := &config.Config{Orgs: []string{"default-org"}, Format: "json", OutputDir: "/tmp/reports"}
+	r := NewRunner(mockIO)
+
+	opts, err := r.Run(cfg, "default-user")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if opts.Format != "json" {
+		t.Errorf("Format: got %q, want %q", opts.Format, "json")
+	}
+	if !strings.HasSuffix(opts.OutputPath, ".json") {
+		t.Errorf("OutputPath: got %q, want a .json file", opts.OutputPath)
+	}
+}
+
 func TestRunner_Run_BackFromPeriodToUsername(t *testing.T) {
 	// Flow: org → username → period mode (back) → username → period mode → single day → format
 	mockIO := &MockIO{
@@ -327,7 +385,7 @@ func TestRunner_Run_BackFromPeriodToUsername(t *testing.T) {
 		},
 	}
 
-	cfg := &config.Config{Org: "default-org", Format: "browser"}
+	cfg := &config.Config{Orgs: []string{"default-org"}, Format: "browser"}
 	r := NewRunner(mockIO)
 
 	opts, err := r.Run(cfg, "default-user")
@@ -352,14 +410,14 @@ func TestRunner_Run_BackFromFormatToPeriod(t *testing.T) {
 		selectResponses: []int{
 			0, // Period type: Single day
 			0, // Select date: Today
-			3, // Output format: Back
+			8, // Output format: Back
 			0, // Period type: Single day (after back)
 			0, // Select date: Today
 			0, // Output format: browser
 		},
 	}
 
-	cfg := &config.Config{Org: "default-org", Format: "browser"}
+	cfg := &config.Config{Orgs: []string{"default-org"}, Format: "browser"}
 	r := NewRunner(mockIO)
 
 	opts, err := r.Run(cfg, "default-user")
@@ -386,7 +444,7 @@ func TestRunner_Run_DateRangeLastWeek(t *testing.T) {
 		},
 	}
 
-	cfg := &config.Config{Org: "default-org", Format: "browser"}
+	cfg := &config.Config{Orgs: []string{"default-org"}, Format: "browser"}
 	r := NewRunner(mockIO)
 
 	opts, err := r.Run(cfg, "default-user")
@@ -399,6 +457,31 @@ func TestRunner_Run_DateRangeLastWeek(t *testing.T) {
 	}
 }
 
+func TestRunner_Run_DateRangeLastWeek_SundayStart(t *testing.T) {
+	mockIO := &MockIO{
+		readLineResponses: []string{"my-org", "testuser", ""},
+		selectResponses:   []int{1, 1, 0}, // Date range → Last week → browser
+	}
+
+	cfg := &config.Config{Format: "browser", Calendar: &period.Calendar{WeekStart: time.Sunday}}
+	r := NewRunner(mockIO)
+
+	opts, err := r.Run(cfg, "default-user")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if opts.StartDate.Weekday() != time.Sunday {
+		t.Errorf("StartDate weekday: got %v, want Sunday", opts.StartDate.Weekday())
+	}
+	if opts.EndDate.Weekday() != time.Saturday {
+		t.Errorf("EndDate weekday: got %v, want Saturday", opts.EndDate.Weekday())
+	}
+	if !opts.EndDate.AddDate(0, 0, -6).Equal(opts.StartDate) {
+		t.Errorf("range: got %v - %v, want 7 days", opts.StartDate, opts.EndDate)
+	}
+}
+
 func TestRunner_Run_DateRangeLastMonth(t *testing.T) {
 	mockIO := &MockIO{
 		readLineResponses: []string{
@@ -413,7 +496,7 @@ func TestRunner_Run_DateRangeLastMonth(t *testing.T) {
 		},
 	}
 
-	cfg := &config.Config{Org: "default-org", Format: "browser"}
+	cfg := &config.Config{Orgs: []string{"default-org"}, Format: "browser"}
 	r := NewRunner(mockIO)
 
 	opts, err := r.Run(cfg, "default-user")
@@ -435,13 +518,13 @@ func TestRunner_Run_DateRangeSelectMonth(t *testing.T) {
 		},
 		selectResponses: []int{
 			1, // Period type: Date range
-			4, // Select range: Select month
+			8, // Select range: Select month
 			2, // Select month: 3rd option (2 months ago)
 			0, // Output format: browser
 		},
 	}
 
-	cfg := &config.Config{Org: "default-org", Format: "browser"}
+	cfg := &config.Config{Orgs: []string{"default-org"}, Format: "browser"}
 	r := NewRunner(mockIO)
 
 	opts, err := r.Run(cfg, "default-user")
@@ -468,12 +551,12 @@ func TestRunner_Run_DateRangeEnterDates(t *testing.T) {
 		},
 		selectResponses: []int{
 			1, // Period type: Date range
-			5, // Select range: Enter dates
+			9, // Select range: Enter dates
 			0, // Output format: browser
 		},
 	}
 
-	cfg := &config.Config{Org: "default-org", Format: "browser"}
+	cfg := &config.Config{Orgs: []string{"default-org"}, Format: "browser"}
 	r := NewRunner(mockIO)
 
 	opts, err := r.Run(cfg, "default-user")
@@ -508,7 +591,7 @@ func TestRunner_Run_SingleDayYesterday(t *testing.T) {
 		},
 	}
 
-	cfg := &config.Config{Org: "default-org", Format: "browser"}
+	cfg := &config.Config{Orgs: []string{"default-org"}, Format: "browser"}
 	r := NewRunner(mockIO)
 
 	opts, err := r.Run(cfg, "default-user")
@@ -538,7 +621,7 @@ func TestRunner_Run_SingleDayEnterDate(t *testing.T) {
 		},
 	}
 
-	cfg := &config.Config{Org: "default-org", Format: "browser"}
+	cfg := &config.Config{Orgs: []string{"default-org"}, Format: "browser"}
 	r := NewRunner(mockIO)
 
 	opts, err := r.Run(cfg, "default-user")
@@ -569,7 +652,7 @@ func TestRunner_Run_SingleDayBack(t *testing.T) {
 		},
 	}
 
-	cfg := &config.Config{Org: "default-org", Format: "browser"}
+	cfg := &config.Config{Orgs: []string{"default-org"}, Format: "browser"}
 	r := NewRunner(mockIO)
 
 	opts, err := r.Run(cfg, "default-user")
@@ -591,15 +674,15 @@ func TestRunner_Run_DateRangeBack(t *testing.T) {
 			"",         // confirmDateRange (Enter = OK)
 		},
 		selectResponses: []int{
-			1, // Period type: Date range
-			6, // Select range: Back
-			0, // Period type: Single day (after back)
-			0, // Select date: Today
-			0, // Output format: browser
+			1,  // Period type: Date range
+			11, // Select range: Back
+			0,  // Period type: Single day (after back)
+			0,  // Select date: Today
+			0,  // Output format: browser
 		},
 	}
 
-	cfg := &config.Config{Org: "default-org", Format: "browser"}
+	cfg := &config.Config{Orgs: []string{"default-org"}, Format: "browser"}
 	r := NewRunner(mockIO)
 
 	opts, err := r.Run(cfg, "default-user")
@@ -760,7 +843,7 @@ func TestRunner_Run_DefaultFormatIdx(t *testing.T) {
 		},
 	}
 
-	cfg := &config.Config{Org: "default-org", Format: "html", OutputDir: ""}
+	cfg := &config.Config{Orgs: []string{"default-org"}, Format: "html", OutputDir: ""}
 	r := NewRunner(mockIO)
 
 	opts, err := r.Run(cfg, "default-user")
@@ -788,7 +871,7 @@ func TestRunner_Run_BrowserNoOutputPath(t *testing.T) {
 		},
 	}
 
-	cfg := &config.Config{Org: "default-org", Format: "browser", OutputDir: "/tmp/reports"}
+	cfg := &config.Config{Orgs: []string{"default-org"}, Format: "browser", OutputDir: "/tmp/reports"}
 	r := NewRunner(mockIO)
 
 	opts, err := r.Run(cfg, "default-user")
@@ -815,7 +898,7 @@ func TestRunner_Run_DateRangeThisMonth(t *testing.T) {
 		},
 	}
 
-	cfg := &config.Config{Org: "default-org", Format: "browser"}
+	cfg := &config.Config{Orgs: []string{"default-org"}, Format: "browser"}
 	r := NewRunner(mockIO)
 
 	opts, err := r.Run(cfg, "default-user")
@@ -830,3 +913,72 @@ func TestRunner_Run_DateRangeThisMonth(t *testing.T) {
 		t.Errorf("StartDate should be first of month, got day %d", opts.StartDate.Day())
 	}
 }
+
+func TestRunner_Run_DateRangeQuarterAndFiscalYear(t *testing.T) {
+	tests := []struct {
+		name      string
+		selectIdx int
+		wantType  period.Type
+		wantMonth func(time.Month) bool
+	}{
+		{"This quarter", 4, period.TypeQuarter, func(m time.Month) bool { return m%3 == 1 }},
+		{"Last quarter", 5, period.TypeQuarter, func(m time.Month) bool { return m%3 == 1 }},
+		{"This fiscal year", 6, period.TypeYear, func(m time.Month) bool { return m == time.April }},
+		{"Last fiscal year", 7, period.TypeYear, func(m time.Month) bool { return m == time.April }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockIO := &MockIO{
+				readLineResponses: []string{"my-org", "testuser", ""},
+				selectResponses:   []int{1, tt.selectIdx, 0},
+			}
+
+			cfg := &config.Config{Format: "browser", Calendar: &period.Calendar{FiscalYearStart: time.April}}
+			opts, err := NewRunner(mockIO).Run(cfg, "default-user")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if opts.PeriodType != tt.wantType {
+				t.Errorf("PeriodType: got %q, want %q", opts.PeriodType, tt.wantType)
+			}
+			if opts.StartDate.Day() != 1 || !tt.wantMonth(opts.StartDate.Month()) {
+				t.Errorf("StartDate: got %v, want the first day of a fiscal quarter/year", opts.StartDate)
+			}
+		})
+	}
+}
+
+func TestRunner_Run_DateRangeExpression(t *testing.T) {
+	mockIO := &MockIO{
+		readLineResponses: []string{
+			"my-org",    // Organization
+			"testuser",  // Username
+			"fortnight", // Period (invalid, asked again)
+			"last-3d",   // Period
+			"",          // confirmDateRange (Enter = OK)
+		},
+		selectResponses: []int{
+			1,  // Period type: Date range
+			10, // Select range: Type a period
+			0,  // Output format: browser
+		},
+	}
+
+	cfg := &config.Config{Format: "browser"}
+	opts, err := NewRunner(mockIO).Run(cfg, "default-user")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if opts.PeriodType != period.TypeCustom {
+		t.Errorf("PeriodType: got %q, want %q", opts.PeriodType, period.TypeCustom)
+	}
+	if !opts.EndDate.AddDate(0, 0, -2).Equal(opts.StartDate) {
+		t.Errorf("period: got %v - %v, want the last 3 days", opts.StartDate, opts.EndDate)
+	}
+	if mockIO.readLineIdx != 5 {
+		t.Errorf("ReadLine calls: got %d, want 5 (invalid expression should be asked again)", mockIO.readLineIdx)
+	}
+}
@@ -0,0 +1,65 @@
+package render
+
+import (
+	_ "embed"
+	"fmt"
+	"html/template"
+	"strings"
+
+	apperrors "github.com/taikicoco/shiraberu/internal/errors"
+)
+
+//go:generate curl -fsSL -o assets/chart.umd.min.js https://cdn.jsdelivr.net/npm/chart.js@4.4.1/dist/chart.umd.min.js
+
+// ChartJSVersion は HTML レポートで使用する Chart.js のバージョン
+const ChartJSVersion = "4.4.1"
+
+// chartJSURL は CDN から読み込む場合の Chart.js の URL
+const chartJSURL = "https://cdn.jsdelivr.net/npm/chart.js@" + ChartJSVersion + "/dist/chart.umd.min.js"
+
+// chartJSSource は埋め込んだ Chart.js のビルド（go generate で取得する。取得せずにビルドした場合は空）
+//
+//go:embed assets/chart.umd.min.js
+var chartJSSource string
+
+// ChartJSMode は HTML レポートへの Chart.js の組み込み方
+type ChartJSMode string
+
+const (
+	ChartJSInline ChartJSMode = "inline" // HTML に埋め込む（ネットワークなしで表示できる）
+	ChartJSCDN    ChartJSMode = "cdn"    // CDN から読み込む（ファイルサイズが小さい）
+)
+
+// ParseChartJSMode は Chart.js の組み込み方（inline または cdn）を解釈する。空の場合はデフォルトの組み込み方を返す
+func ParseChartJSMode(s string) (ChartJSMode, error) {
+	switch mode := ChartJSMode(strings.ToLower(strings.TrimSpace(s))); mode {
+	case "":
+		return defaultChartJSMode(), nil
+	case ChartJSInline, ChartJSCDN:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("unknown Chart.js mode %q (want %s or %s)", s, ChartJSInline, ChartJSCDN)
+	}
+}
+
+// defaultChartJSMode は Chart.js の組み込み方のデフォルトを返す。
+// Chart.js のビルドを埋め込まずにビルドしたバイナリでもレポートを表示できるよう、その場合は CDN から読み込む
+func defaultChartJSMode() ChartJSMode {
+	if chartJSSource == "" {
+		return ChartJSCDN
+	}
+	return ChartJSInline
+}
+
+// chartJSScript は HTML に埋め込む Chart.js と、埋め込まない場合に読み込む URL を返す。
+// 埋め込みのビルドが空の場合、ChartJSInline では CDN に切り替えずにエラーを返す
+func chartJSScript(mode ChartJSMode) (template.JS, string, error) {
+	if mode == ChartJSCDN {
+		return "", chartJSURL, nil
+	}
+	if chartJSSource == "" {
+		return "", "", fmt.Errorf("%w: the embedded Chart.js build (internal/render/assets/chart.umd.min.js) is empty; run go generate ./internal/render and rebuild, or use -chartjs cdn", apperrors.ErrChartJSMissing)
+	}
+	// スクリプト内の文字列に含まれる "</script" で script 要素が閉じられないようにする
+	return template.JS(strings.ReplaceAll(chartJSSource, "</script", `<\/script`)), "", nil
+}
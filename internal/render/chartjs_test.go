@@ -0,0 +1,130 @@
+package render
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	apperrors "github.com/taikicoco/shiraberu/internal/errors"
+)
+
+func TestParseChartJSMode(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    ChartJSMode
+		wantErr bool
+	}{
+		{"inline", ChartJSInline, false},
+		{" CDN ", ChartJSCDN, false},
+		{"local", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseChartJSMode(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseChartJSMode(%q): expected error", tt.input)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseChartJSMode(%q) failed: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseChartJSMode(%q): got %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseChartJSMode_Default(t *testing.T) {
+	original := chartJSSource
+	t.Cleanup(func() { chartJSSource = original })
+
+	tests := []struct {
+		name   string
+		source string
+		want   ChartJSMode
+	}{
+		{"embedded", "window.Chart=function(){};", ChartJSInline},
+		{"not embedded", "", ChartJSCDN},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chartJSSource = tt.source
+
+			got, err := ParseChartJSMode("")
+			if err != nil {
+				t.Fatalf("ParseChartJSMode failed: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChartJSEmbedded(t *testing.T) {
+	if len(chartJSSource) == 0 {
+		t.Fatal("the embedded Chart.js build is empty; run go generate ./internal/render")
+	}
+	if banner := chartJSSource[:min(len(chartJSSource), 200)]; !strings.Contains(banner, "Chart.js v"+ChartJSVersion) {
+		t.Errorf("embedded build is not Chart.js v%s: %q", ChartJSVersion, banner)
+	}
+}
+
+func TestRenderHTML_ChartJSMissing(t *testing.T) {
+	original := chartJSSource
+	t.Cleanup(func() { chartJSSource = original })
+	chartJSSource = ""
+
+	report, previous := markdownSectionsReport()
+	var buf bytes.Buffer
+	err := RenderHTML(&buf, report, previous, WithChartJS(ChartJSInline))
+	if !errors.Is(err, apperrors.ErrChartJSMissing) {
+		t.Fatalf("expected ErrChartJSMissing, got %v", err)
+	}
+	if strings.Contains(buf.String(), chartJSURL) {
+		t.Error("inline mode fell back to the CDN")
+	}
+}
+
+func TestRenderHTML_ChartJS(t *testing.T) {
+	original := chartJSSource
+	t.Cleanup(func() { chartJSSource = original })
+	chartJSSource = `window.Chart=function(){};var s="</script>";`
+
+	tests := []struct {
+		name       string
+		source     string
+		opts       []Option
+		wantInline bool
+	}{
+		{"inline by default", chartJSSource, nil, true},
+		{"cdn", chartJSSource, []Option{WithChartJS(ChartJSCDN)}, false},
+		{"cdn without embedded build", "", []Option{WithChartJS(ChartJSCDN)}, false},
+		{"cdn by default without embedded build", "", nil, false},
+	}
+
+	report, previous := markdownSectionsReport()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chartJSSource = tt.source
+
+			var buf bytes.Buffer
+			if err := RenderHTML(&buf, report, previous, tt.opts...); err != nil {
+				t.Fatalf("RenderHTML failed: %v", err)
+			}
+			html := buf.String()
+
+			hasInline := strings.Contains(html, `<script>window.Chart=function(){};var s="<\/script>";</script>`)
+			hasCDN := strings.Contains(html, `<script src="`+chartJSURL+`"></script>`)
+			if hasInline != tt.wantInline || hasCDN == tt.wantInline {
+				t.Errorf("inline: %v, cdn: %v, want inline: %v", hasInline, hasCDN, tt.wantInline)
+			}
+		})
+	}
+}
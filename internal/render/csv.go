@@ -0,0 +1,123 @@
+package render
+
+import (
+	"encoding/csv"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/taikicoco/shiraberu/internal/github"
+	"github.com/taikicoco/shiraberu/internal/pr"
+)
+
+// csvPRHeader は RenderCSV の列
+var csvPRHeader = []string{"date", "category", "repository", "title", "url", "state", "draft", "additions", "deletions", "changed_files", "comments"}
+
+// csvDailyStatsHeader は RenderDailyStatsCSV の列
+var csvDailyStatsHeader = []string{"date", "opened", "draft", "merged", "closed", "reviewed", "additions", "deletions", "total", "approved", "changes_requested", "commented", "business_day", "holiday"}
+
+// RenderCSV はPRを活動の種類ごとに1行ずつ、日付の古い順に RFC 4180 形式で出力する。
+// 同じPRが複数の種類（例: Opened と Merged）に該当する場合はそれぞれの行に出力される
+func RenderCSV(w io.Writer, report *pr.Report, opts ...Option) error {
+	o := newOptions(opts)
+	cw := newCSVWriter(w, o)
+
+	if err := cw.Write(csvPRHeader); err != nil {
+		return err
+	}
+
+	days := make([]pr.DailyPRs, len(report.Days))
+	copy(days, report.Days)
+	sort.SliceStable(days, func(i, j int) bool {
+		return days[i].Date.Before(days[j].Date)
+	})
+
+	for _, day := range days {
+		date := day.Date.Format("2006-01-02")
+		categories := []struct {
+			name string
+			prs  []github.PullRequest
+		}{
+			{"opened", day.Opened},
+			{"draft", day.Draft},
+			{"merged", day.Merged},
+			{"closed", day.Closed},
+			{"reviewed", day.Reviewed},
+		}
+		for _, c := range categories {
+			for _, p := range c.prs {
+				if err := cw.Write([]string{
+					date,
+					c.name,
+					csvText(p.Repository),
+					csvText(p.Title),
+					csvText(p.URL),
+					p.State,
+					strconv.FormatBool(p.IsDraft),
+					strconv.Itoa(p.Additions),
+					strconv.Itoa(p.Deletions),
+					strconv.Itoa(p.ChangedFiles),
+					strconv.Itoa(p.Comments),
+				}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// RenderDailyStatsCSV は calcDailyStats の日別統計を RFC 4180 形式で出力する
+func RenderDailyStatsCSV(w io.Writer, report *pr.Report, opts ...Option) error {
+	o := newOptions(opts)
+	cw := newCSVWriter(w, o)
+
+	if err := cw.Write(csvDailyStatsHeader); err != nil {
+		return err
+	}
+
+	stats := calcDailyStats(report)
+	markBusinessDays(stats, o.businessCalendar)
+	for _, s := range stats {
+		if err := cw.Write([]string{
+			s.Date,
+			strconv.Itoa(s.OpenedCount),
+			strconv.Itoa(s.DraftCount),
+			strconv.Itoa(s.MergedCount),
+			strconv.Itoa(s.ClosedCount),
+			strconv.Itoa(s.ReviewedCount),
+			strconv.Itoa(s.Additions),
+			strconv.Itoa(s.Deletions),
+			strconv.Itoa(s.TotalPRs),
+			strconv.Itoa(s.ApprovedCount),
+			strconv.Itoa(s.ChangesRequestedCount),
+			strconv.Itoa(s.CommentedCount),
+			strconv.FormatBool(s.IsBusinessDay),
+			csvText(s.HolidayName),
+		}); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// csvText は表計算ソフトで数式として実行されないよう、= + - @ などで始まる文字列の先頭に ' を付ける
+func csvText(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+// newCSVWriter は RFC 4180 に従い CRLF で改行する csv.Writer を作成する
+func newCSVWriter(w io.Writer, o *options) *csv.Writer {
+	cw := csv.NewWriter(w)
+	cw.Comma = o.delimiter
+	cw.UseCRLF = true
+	return cw
+}
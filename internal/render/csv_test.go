@@ -0,0 +1,140 @@
+package render
+
+import (
+	"bytes"
+	"encoding/csv"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/taikicoco/shiraberu/internal/github"
+	"github.com/taikicoco/shiraberu/internal/holiday"
+	"github.com/taikicoco/shiraberu/internal/pr"
+	"github.com/taikicoco/shiraberu/internal/timezone"
+)
+
+func csvTestReport() *pr.Report {
+	return &pr.Report{
+		StartDate: time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST),
+		EndDate:   time.Date(2025, 1, 3, 0, 0, 0, 0, timezone.JST),
+		// Newest first, as returned by the fetcher
+		Days: []pr.DailyPRs{
+			{
+				Date: time.Date(2025, 1, 3, 0, 0, 0, 0, timezone.JST),
+				Merged: []github.PullRequest{
+					{Title: "Fix \"quoted\", with comma", URL: "https://github.com/org/repo/pull/2", Repository: "org/repo", State: "merged", Additions: 5, Deletions: 1, ChangedFiles: 2, Comments: 3},
+				},
+			},
+			{
+				Date: time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST),
+				Draft: []github.PullRequest{
+					{Title: "Multi\nline", URL: "https://github.com/org/repo/pull/1", Repository: "org/repo", State: "open", IsDraft: true},
+				},
+			},
+		},
+	}
+}
+
+func TestRenderCSV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := RenderCSV(&buf, csvTestReport()); err != nil {
+		t.Fatalf("RenderCSV failed: %v", err)
+	}
+
+	// Line breaks inside quoted fields are written as CRLF as well
+	want := "date,category,repository,title,url,state,draft,additions,deletions,changed_files,comments\r\n" +
+		"2025-01-01,draft,org/repo,\"Multi\r\nline\",https://github.com/org/repo/pull/1,open,true,0,0,0,0\r\n" +
+		"2025-01-03,merged,org/repo,\"Fix \"\"quoted\"\", with comma\",https://github.com/org/repo/pull/2,merged,false,5,1,2,3\r\n"
+	if buf.String() != want {
+		t.Errorf("RenderCSV:\n got %q\nwant %q", buf.String(), want)
+	}
+
+	// Round trip through a standard CSV reader
+	records, err := csv.NewReader(strings.NewReader(buf.String())).ReadAll()
+	if err != nil {
+		t.Fatalf("output is not valid CSV: %v", err)
+	}
+	if len(records) != 3 || records[1][3] != "Multi\nline" || records[2][3] != `Fix "quoted", with comma` {
+		t.Errorf("records: got %q", records)
+	}
+}
+
+func TestRenderCSV_FormulaTitles(t *testing.T) {
+	tests := []struct {
+		title string
+		want  string
+	}{
+		{`=HYPERLINK("https://example.com","x")`, `'=HYPERLINK("https://example.com","x")`},
+		{"+1 for caching", "'+1 for caching"},
+		{"-Werror", "'-Werror"},
+		{"@mention", "'@mention"},
+		{"\tindented", "'\tindented"},
+		{"a = b", "a = b"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			report := &pr.Report{Days: []pr.DailyPRs{{
+				Date:   time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST),
+				Merged: []github.PullRequest{{Title: tt.title, Repository: "org/repo"}},
+			}}}
+
+			var buf bytes.Buffer
+			if err := RenderCSV(&buf, report); err != nil {
+				t.Fatalf("RenderCSV failed: %v", err)
+			}
+			records, err := csv.NewReader(strings.NewReader(buf.String())).ReadAll()
+			if err != nil {
+				t.Fatalf("output is not valid CSV: %v", err)
+			}
+			if got := records[1][3]; got != tt.want {
+				t.Errorf("title: got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderCSV_TSV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := RenderCSV(&buf, csvTestReport(), WithDelimiter('\t')); err != nil {
+		t.Fatalf("RenderCSV failed: %v", err)
+	}
+
+	r := csv.NewReader(strings.NewReader(buf.String()))
+	r.Comma = '\t'
+	records, err := r.ReadAll()
+	if err != nil {
+		t.Fatalf("output is not valid TSV: %v", err)
+	}
+	if !reflect.DeepEqual(records[0], csvPRHeader) {
+		t.Errorf("header: got %q", records[0])
+	}
+	if records[2][3] != `Fix "quoted", with comma` {
+		t.Errorf("title: got %q", records[2][3])
+	}
+}
+
+func TestRenderDailyStatsCSV(t *testing.T) {
+	cal := holiday.New(nil, []holiday.Holiday{{Date: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), Name: "New Year's Day"}})
+
+	var buf bytes.Buffer
+	if err := RenderDailyStatsCSV(&buf, csvTestReport(), WithBusinessCalendar(cal)); err != nil {
+		t.Fatalf("RenderDailyStatsCSV failed: %v", err)
+	}
+
+	records, err := csv.NewReader(strings.NewReader(buf.String())).ReadAll()
+	if err != nil {
+		t.Fatalf("output is not valid CSV: %v", err)
+	}
+	want := [][]string{
+		csvDailyStatsHeader,
+		{"2025-01-01", "0", "1", "0", "0", "0", "0", "0", "1", "0", "0", "0", "false", "New Year's Day"},
+		{"2025-01-02", "0", "0", "0", "0", "0", "0", "0", "0", "0", "0", "0", "true", ""},
+		{"2025-01-03", "0", "0", "1", "0", "0", "5", "1", "1", "0", "0", "0", "true", ""},
+	}
+	if !reflect.DeepEqual(records, want) {
+		t.Errorf("RenderDailyStatsCSV:\n got %q\nwant %q", records, want)
+	}
+}
@@ -2,7 +2,6 @@ package render
 
 import (
 	"embed"
-	"encoding/json"
 	"html/template"
 	"io"
 	"sort"
@@ -17,42 +16,63 @@ var templateFS embed.FS
 var htmlTemplate *template.Template
 
 func init() {
-	funcMap := template.FuncMap{
-		"add": func(a, b int) int { return a + b },
-		"json": func(v interface{}) template.JS {
-			b, _ := json.Marshal(v)
-			return template.JS(b)
-		},
-	}
 	var err error
-	htmlTemplate, err = template.New("").Funcs(funcMap).ParseFS(templateFS, "templates/*.html")
+	htmlTemplate, err = parseHTMLTemplate()
 	if err != nil {
 		panic(err)
 	}
 }
 
-func RenderHTML(w io.Writer, report *pr.Report, previousReport *pr.Report) error {
+// parseHTMLTemplate は組み込みの HTML テンプレートを解釈する。
+// 実行済みのテンプレートは Clone できないため、ユーザー指定のテンプレートはこれに重ねて解釈する
+func parseHTMLTemplate() (*template.Template, error) {
+	return template.New("").Funcs(htmlFuncs()).ParseFS(templateFS, "templates/*.html")
+}
+
+func RenderHTML(w io.Writer, report *pr.Report, previousReport *pr.Report, opts ...Option) error {
+	o := newOptions(opts)
 	summary := calcSummary(report)
 	dailyStats := calcDailyStats(report)
+	markBusinessDays(dailyStats, o.businessCalendar)
 	weeklyStats := calcWeeklyStats(report)
 	monthlyStats := calcMonthlyStats(report)
+	quarterlyStats := calcQuarterlyStats(report)
 	repoStats := calcRepoStats(report)
+	labelStats := calcLabelStats(report)
+	orgStats := calcOrgStats(report)
 	summaryDiff := calcSummaryDiff(summary, previousReport)
+	summaryDiff.Label = o.baselineLabel
+	summaryTrend := calcSummaryTrend(summary, o.history)
 	daysJSON := convertToDaysJSON(report)
 
 	data := HTMLData{
 		Report:            report,
 		Summary:           summary,
 		SummaryDiff:       summaryDiff,
+		SummaryTrend:      summaryTrend,
 		DailyStats:        dailyStats,
 		WeeklyStats:       weeklyStats,
 		MonthlyStats:      monthlyStats,
+		QuarterlyStats:    quarterlyStats,
 		RepoStats:         repoStats,
+		LabelStats:        labelStats,
+		OrgStats:          orgStats,
+		BusinessDayStat:   calcBusinessDayStat(dailyStats),
+		NonBusinessDays:   calcNonBusinessDays(dailyStats),
 		Weekdays:          []string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		WeekStart:         int(report.Calendar.FirstDayOfWeek()),
 		PeriodLabel:       formatPeriod(report.StartDate, report.EndDate),
 		DaysJSON:          daysJSON,
 		OriginalStartDate: report.StartDate.Format("2006-01-02"),
 		OriginalEndDate:   report.EndDate.Format("2006-01-02"),
+		chartJS:           o.chartJS,
+	}
+	if t := o.template; t != nil && t.html != nil {
+		return t.html.ExecuteTemplate(w, t.htmlName, data)
+	}
+	// 組み込みのテンプレートは必ず Chart.js を使用するため、出力を始める前に確認する
+	if _, _, err := chartJSScript(o.chartJS); err != nil {
+		return err
 	}
 	return htmlTemplate.ExecuteTemplate(w, "report.html", data)
 }
@@ -69,6 +89,7 @@ func convertToDaysJSON(report *pr.Report) []DayJSON {
 			Opened:   []PRJSON{},
 			Draft:    []PRJSON{},
 			Merged:   []PRJSON{},
+			Closed:   []PRJSON{},
 			Reviewed: []PRJSON{},
 		}
 	}
@@ -81,6 +102,7 @@ func convertToDaysJSON(report *pr.Report) []DayJSON {
 			Opened:   convertPRsToJSON(day.Opened),
 			Draft:    convertPRsToJSON(day.Draft),
 			Merged:   convertPRsToJSON(day.Merged),
+			Closed:   convertPRsToJSON(day.Closed),
 			Reviewed: convertPRsToJSON(day.Reviewed),
 		}
 	}
@@ -100,15 +122,21 @@ func convertToDaysJSON(report *pr.Report) []DayJSON {
 func convertPRsToJSON(prs []github.PullRequest) []PRJSON {
 	result := make([]PRJSON, 0, len(prs))
 	for _, p := range prs {
+		var reviewStates []string
+		for _, r := range p.Reviews {
+			reviewStates = append(reviewStates, r.State)
+		}
 		result = append(result, PRJSON{
-			Title:      p.Title,
-			URL:        p.URL,
-			Repository: p.Repository,
-			State:      p.State,
-			IsDraft:    p.IsDraft,
-			Additions:  p.Additions,
-			Deletions:  p.Deletions,
-			Comments:   p.Comments,
+			Title:        p.Title,
+			URL:          p.URL,
+			Repository:   p.Repository,
+			State:        p.State,
+			IsDraft:      p.IsDraft,
+			Additions:    p.Additions,
+			Deletions:    p.Deletions,
+			Comments:     p.Comments,
+			ReviewStates: reviewStates,
+			Labels:       p.Labels,
 		})
 	}
 	return result
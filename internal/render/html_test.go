@@ -2,11 +2,15 @@ package render
 
 import (
 	"bytes"
+	"reflect"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/taikicoco/shiraberu/internal/github"
+	"github.com/taikicoco/shiraberu/internal/holiday"
+	"github.com/taikicoco/shiraberu/internal/period"
 	"github.com/taikicoco/shiraberu/internal/pr"
 	"github.com/taikicoco/shiraberu/internal/timezone"
 )
@@ -484,7 +488,7 @@ func TestRenderHTML(t *testing.T) {
 		GeneratedAt: time.Date(2025, 1, 15, 10, 30, 0, 0, timezone.JST),
 		StartDate:   time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST),
 		EndDate:     time.Date(2025, 1, 15, 0, 0, 0, 0, timezone.JST),
-		Org:         "test-org",
+		Orgs:        []string{"test-org"},
 		Days: []pr.DailyPRs{
 			{
 				Date: time.Date(2025, 1, 10, 0, 0, 0, 0, timezone.JST),
@@ -529,7 +533,7 @@ func TestRenderHTML_Empty(t *testing.T) {
 		GeneratedAt: time.Date(2025, 1, 15, 10, 30, 0, 0, timezone.JST),
 		StartDate:   time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST),
 		EndDate:     time.Date(2025, 1, 15, 0, 0, 0, 0, timezone.JST),
-		Org:         "test-org",
+		Orgs:        []string{"test-org"},
 		Days:        []pr.DailyPRs{},
 	}
 
@@ -550,7 +554,7 @@ func TestRenderMarkdown(t *testing.T) {
 		GeneratedAt: time.Date(2025, 1, 15, 10, 30, 0, 0, timezone.JST),
 		StartDate:   time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST),
 		EndDate:     time.Date(2025, 1, 15, 0, 0, 0, 0, timezone.JST),
-		Org:         "test-org",
+		Orgs:        []string{"test-org"},
 		Days: []pr.DailyPRs{
 			{
 				Date: time.Date(2025, 1, 10, 0, 0, 0, 0, timezone.JST),
@@ -575,7 +579,7 @@ func TestRenderMarkdown(t *testing.T) {
 	}
 
 	var buf bytes.Buffer
-	err := RenderMarkdown(&buf, report)
+	err := RenderMarkdown(&buf, report, nil)
 	if err != nil {
 		t.Fatalf("RenderMarkdown failed: %v", err)
 	}
@@ -607,12 +611,12 @@ func TestRenderMarkdown_Empty(t *testing.T) {
 		GeneratedAt: time.Date(2025, 1, 15, 10, 30, 0, 0, timezone.JST),
 		StartDate:   time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST),
 		EndDate:     time.Date(2025, 1, 15, 0, 0, 0, 0, timezone.JST),
-		Org:         "test-org",
+		Orgs:        []string{"test-org"},
 		Days:        []pr.DailyPRs{},
 	}
 
 	var buf bytes.Buffer
-	err := RenderMarkdown(&buf, report)
+	err := RenderMarkdown(&buf, report, nil)
 	if err != nil {
 		t.Fatalf("RenderMarkdown failed: %v", err)
 	}
@@ -680,6 +684,105 @@ func TestCalcWeeklyStats_WithGap(t *testing.T) {
 	}
 }
 
+func TestCalcWeeklyStats_SundayStart(t *testing.T) {
+	// Week 1: 2024-12-29 〜 2025-01-04
+	// Week 2: 2025-01-05 〜 2025-01-11
+	report := &pr.Report{
+		StartDate: time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST),
+		EndDate:   time.Date(2025, 1, 7, 0, 0, 0, 0, timezone.JST),
+		Calendar:  &period.Calendar{WeekStart: time.Sunday},
+		Days: []pr.DailyPRs{
+			{
+				Date:   time.Date(2025, 1, 4, 0, 0, 0, 0, timezone.JST), // Saturday, Week 1
+				Opened: []github.PullRequest{{}},
+			},
+			{
+				Date:   time.Date(2025, 1, 5, 0, 0, 0, 0, timezone.JST), // Sunday, Week 2
+				Merged: []github.PullRequest{{}, {}},
+			},
+		},
+	}
+
+	stats := calcWeeklyStats(report)
+
+	if len(stats) != 2 {
+		t.Fatalf("len(stats): got %d, want 2", len(stats))
+	}
+	if stats[0].StartDate != "2024-12-29" || stats[0].EndDate != "2025-01-04" || stats[0].Week != "12/29 〜 1/4" {
+		t.Errorf("stats[0]: got %+v, want week 2024-12-29 〜 2025-01-04", stats[0])
+	}
+	if stats[0].OpenedCount != 1 || stats[0].MergedCount != 0 {
+		t.Errorf("stats[0] counts: got opened=%d merged=%d, want 1/0", stats[0].OpenedCount, stats[0].MergedCount)
+	}
+	if stats[1].StartDate != "2025-01-05" || stats[1].MergedCount != 2 {
+		t.Errorf("stats[1]: got %+v, want week from 2025-01-05 with 2 merged", stats[1])
+	}
+
+	var html bytes.Buffer
+	if err := RenderHTML(&html, report, nil); err != nil {
+		t.Fatalf("RenderHTML failed: %v", err)
+	}
+	// html/template が JS の値の前後に入れる空白に依存しないよう正規表現で確認する
+	if !regexp.MustCompile(`const weekStart\s*=\s*0\s*;`).MatchString(html.String()) {
+		t.Error("HTML should pass the week start to the scripts")
+	}
+}
+
+func TestCalcQuarterlyStats(t *testing.T) {
+	// FY2024 Q4: 2025-01-01 〜 2025-03-31
+	// FY2025 Q1: 2025-04-01 〜 2025-06-30
+	report := &pr.Report{
+		StartDate: time.Date(2025, 3, 15, 0, 0, 0, 0, timezone.JST),
+		EndDate:   time.Date(2025, 4, 15, 0, 0, 0, 0, timezone.JST),
+		Calendar:  &period.Calendar{FiscalYearStart: time.April},
+		Days: []pr.DailyPRs{
+			{
+				Date:   time.Date(2025, 3, 31, 0, 0, 0, 0, timezone.JST),
+				Merged: []github.PullRequest{{}, {}},
+			},
+			{
+				Date:     time.Date(2025, 4, 1, 0, 0, 0, 0, timezone.JST),
+				Opened:   []github.PullRequest{{}},
+				Reviewed: []github.PullRequest{{}},
+			},
+		},
+	}
+
+	stats := calcQuarterlyStats(report)
+
+	want := []QuarterlyStat{
+		{Quarter: "FY2024 Q4", StartDate: "2025-01-01", EndDate: "2025-03-31", MergedCount: 2},
+		{Quarter: "FY2025 Q1", StartDate: "2025-04-01", EndDate: "2025-06-30", OpenedCount: 1, ReviewedCount: 1},
+	}
+	if len(stats) != len(want) {
+		t.Fatalf("len(stats): got %d, want %d", len(stats), len(want))
+	}
+	for i := range want {
+		if stats[i] != want[i] {
+			t.Errorf("stats[%d]: got %+v, want %+v", i, stats[i], want[i])
+		}
+	}
+
+	var html bytes.Buffer
+	if err := RenderHTML(&html, report, nil); err != nil {
+		t.Fatalf("RenderHTML failed: %v", err)
+	}
+	if !strings.Contains(html.String(), `data-mode="quarter"`) {
+		t.Error("HTML should contain the Quarter filter tab")
+	}
+}
+
+func TestCalcQuarterlyStats_Empty(t *testing.T) {
+	report := &pr.Report{
+		StartDate: time.Date(2025, 1, 2, 0, 0, 0, 0, timezone.JST),
+		EndDate:   time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST),
+	}
+
+	if stats := calcQuarterlyStats(report); len(stats) != 0 {
+		t.Errorf("len(stats): got %d, want 0", len(stats))
+	}
+}
+
 func TestCalcMonthlyStats_Empty(t *testing.T) {
 	// StartDate > EndDate の場合は0件
 	report := &pr.Report{
@@ -844,3 +947,595 @@ func TestConvertToDaysJSON_SingleDay(t *testing.T) {
 		t.Errorf("days[0].Date: got %s, want 2025-01-01", days[0].Date)
 	}
 }
+
+func TestRender_Host(t *testing.T) {
+	report := &pr.Report{
+		GeneratedAt: time.Date(2025, 1, 15, 10, 30, 0, 0, timezone.JST),
+		StartDate:   time.Date(2025, 1, 15, 0, 0, 0, 0, timezone.JST),
+		EndDate:     time.Date(2025, 1, 15, 0, 0, 0, 0, timezone.JST),
+		Host:        "ghe.example.com",
+		Orgs:        []string{"test-org"},
+		Days:        []pr.DailyPRs{},
+	}
+
+	var md bytes.Buffer
+	if err := RenderMarkdown(&md, report, nil); err != nil {
+		t.Fatalf("RenderMarkdown failed: %v", err)
+	}
+	if !strings.Contains(md.String(), "Host: ghe.example.com") {
+		t.Error("Markdown output should contain host")
+	}
+
+	var html bytes.Buffer
+	if err := RenderHTML(&html, report, nil); err != nil {
+		t.Fatalf("RenderHTML failed: %v", err)
+	}
+	if !strings.Contains(html.String(), "Host: ghe.example.com") {
+		t.Error("HTML header should contain host")
+	}
+}
+
+func TestRender_Warnings(t *testing.T) {
+	report := &pr.Report{
+		GeneratedAt: time.Date(2025, 1, 15, 10, 30, 0, 0, timezone.JST),
+		StartDate:   time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST),
+		EndDate:     time.Date(2025, 1, 15, 0, 0, 0, 0, timezone.JST),
+		Orgs:        []string{"test-org"},
+		Warnings:    []string{"search results truncated at the 1000-result limit"},
+	}
+
+	var md bytes.Buffer
+	if err := RenderMarkdown(&md, report, nil); err != nil {
+		t.Fatalf("RenderMarkdown failed: %v", err)
+	}
+	if !strings.Contains(md.String(), "> ⚠ search results truncated") {
+		t.Error("Markdown output should contain warning")
+	}
+
+	var html bytes.Buffer
+	if err := RenderHTML(&html, report, nil); err != nil {
+		t.Fatalf("RenderHTML failed: %v", err)
+	}
+	if !strings.Contains(html.String(), `class="warning"`) {
+		t.Error("HTML output should contain warning banner")
+	}
+}
+
+func TestCalcSummary_ReviewVerdicts(t *testing.T) {
+	report := &pr.Report{
+		StartDate: time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST),
+		EndDate:   time.Date(2025, 1, 2, 0, 0, 0, 0, timezone.JST),
+		Days: []pr.DailyPRs{
+			{
+				Date: time.Date(2025, 1, 2, 0, 0, 0, 0, timezone.JST),
+				Reviewed: []github.PullRequest{
+					{Reviews: []github.Review{{State: github.ReviewCommented}, {State: github.ReviewApproved}}},
+					{Reviews: []github.Review{{State: github.ReviewChangesRequested}}},
+				},
+			},
+			{
+				Date: time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST),
+				Reviewed: []github.PullRequest{
+					{Reviews: []github.Review{{State: github.ReviewApproved}, {State: github.ReviewDismissed}}},
+				},
+			},
+		},
+	}
+
+	summary := calcSummary(report)
+	if summary.ApprovedCount != 2 {
+		t.Errorf("ApprovedCount: got %d, want 2", summary.ApprovedCount)
+	}
+	if summary.ChangesRequestedCount != 1 {
+		t.Errorf("ChangesRequestedCount: got %d, want 1", summary.ChangesRequestedCount)
+	}
+	if summary.CommentedCount != 1 {
+		t.Errorf("CommentedCount: got %d, want 1", summary.CommentedCount)
+	}
+
+	stats := calcDailyStats(report)
+	if stats[1].ApprovedCount != 1 || stats[1].ChangesRequestedCount != 1 || stats[1].CommentedCount != 1 {
+		t.Errorf("stats[1] verdicts: got %d/%d/%d, want 1/1/1",
+			stats[1].ApprovedCount, stats[1].ChangesRequestedCount, stats[1].CommentedCount)
+	}
+
+	days := convertToDaysJSON(report)
+	if got := days[1].Reviewed[0].ReviewStates; len(got) != 2 || got[1] != github.ReviewApproved {
+		t.Errorf("ReviewStates: got %v, want [commented approved]", got)
+	}
+
+	var html bytes.Buffer
+	if err := RenderHTML(&html, report, nil); err != nil {
+		t.Fatalf("RenderHTML failed: %v", err)
+	}
+	if !strings.Contains(html.String(), "✓ 2 · ✗ 1 · 💬 1") {
+		t.Error("HTML summary should contain review verdict breakdown")
+	}
+
+	var md bytes.Buffer
+	if err := RenderMarkdown(&md, report, nil); err != nil {
+		t.Fatalf("RenderMarkdown failed: %v", err)
+	}
+	if !strings.Contains(md.String(), "Reviews: 2 approved / 1 changes requested / 1 commented") {
+		t.Error("Markdown should contain review verdict summary")
+	}
+	if !strings.Contains(md.String(), "- Changes requested") {
+		t.Error("Markdown PR line should contain review verdict")
+	}
+}
+
+func TestRender_Closed(t *testing.T) {
+	report := &pr.Report{
+		GeneratedAt: time.Date(2025, 1, 15, 10, 30, 0, 0, timezone.JST),
+		StartDate:   time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST),
+		EndDate:     time.Date(2025, 1, 7, 0, 0, 0, 0, timezone.JST),
+		Orgs:        []string{"test-org"},
+		Days: []pr.DailyPRs{
+			{
+				Date: time.Date(2025, 1, 3, 0, 0, 0, 0, timezone.JST),
+				Closed: []github.PullRequest{
+					{Title: "Abandoned experiment", URL: "https://github.com/test/repo/pull/9", Repository: "repo", State: "closed"},
+				},
+			},
+		},
+	}
+	previous := &pr.Report{
+		Days: []pr.DailyPRs{
+			{Closed: []github.PullRequest{{}, {}, {}}},
+		},
+	}
+
+	summary := calcSummary(report)
+	if summary.ClosedCount != 1 {
+		t.Errorf("ClosedCount: got %d, want 1", summary.ClosedCount)
+	}
+	if diff := calcSummaryDiff(summary, previous); diff.ClosedDiff != -2 {
+		t.Errorf("ClosedDiff: got %d, want -2", diff.ClosedDiff)
+	}
+	if weekly := calcWeeklyStats(report); weekly[0].ClosedCount != 1 {
+		t.Errorf("weekly ClosedCount: got %d, want 1", weekly[0].ClosedCount)
+	}
+	if monthly := calcMonthlyStats(report); monthly[0].ClosedCount != 1 {
+		t.Errorf("monthly ClosedCount: got %d, want 1", monthly[0].ClosedCount)
+	}
+
+	days := convertToDaysJSON(report)
+	if len(days[2].Closed) != 1 {
+		t.Errorf("DaysJSON closed: got %d, want 1", len(days[2].Closed))
+	}
+	if days[0].Closed == nil {
+		t.Error("DaysJSON closed should be an empty slice, not nil")
+	}
+
+	var md bytes.Buffer
+	if err := RenderMarkdown(&md, report, nil); err != nil {
+		t.Fatalf("RenderMarkdown failed: %v", err)
+	}
+	if !strings.Contains(md.String(), "### Closed") {
+		t.Error("Markdown should contain closed section")
+	}
+
+	var html bytes.Buffer
+	if err := RenderHTML(&html, report, previous); err != nil {
+		t.Fatalf("RenderHTML failed: %v", err)
+	}
+	if !strings.Contains(html.String(), `category-title closed`) {
+		t.Error("HTML should contain closed category")
+	}
+	if !strings.Contains(html.String(), `summary-value closed`) {
+		t.Error("HTML should contain closed summary card")
+	}
+}
+
+func TestRender_Labels(t *testing.T) {
+	report := &pr.Report{
+		GeneratedAt: time.Date(2025, 1, 15, 10, 30, 0, 0, timezone.JST),
+		StartDate:   time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST),
+		EndDate:     time.Date(2025, 1, 7, 0, 0, 0, 0, timezone.JST),
+		Orgs:        []string{"test-org"},
+		LabelFilter: pr.LabelFilter{Exclude: []string{"wip"}},
+		Days: []pr.DailyPRs{
+			{
+				Date: time.Date(2025, 1, 3, 0, 0, 0, 0, timezone.JST),
+				Opened: []github.PullRequest{
+					{Title: "Fix crash", URL: "https://github.com/test/repo/pull/1", Repository: "repo", State: "open", Labels: []string{"type/bug", "area/api"}},
+				},
+				Merged: []github.PullRequest{
+					{Title: "Add feature", URL: "https://github.com/test/repo/pull/2", Repository: "repo", State: "merged", Labels: []string{"type/feature", "area/api"}},
+				},
+			},
+		},
+	}
+
+	stats := calcLabelStats(report)
+	want := []LabelStat{{"area/api", 2}, {"type/bug", 1}, {"type/feature", 1}}
+	if len(stats) != len(want) {
+		t.Fatalf("len(LabelStats): got %d, want %d", len(stats), len(want))
+	}
+	for i, s := range stats {
+		if s != want[i] {
+			t.Errorf("LabelStats[%d]: got %+v, want %+v", i, s, want[i])
+		}
+	}
+
+	if days := convertToDaysJSON(report); len(days[2].Opened[0].Labels) != 2 {
+		t.Errorf("PRJSON labels: got %v, want 2 labels", days[2].Opened[0].Labels)
+	}
+
+	var md bytes.Buffer
+	if err := RenderMarkdown(&md, report, nil); err != nil {
+		t.Fatalf("RenderMarkdown failed: %v", err)
+	}
+	if !strings.Contains(md.String(), "(Open) `type/bug` `area/api`") {
+		t.Errorf("Markdown should contain label badges, got:\n%s", md.String())
+	}
+	if !strings.Contains(md.String(), "Labels: (excluding wip)") {
+		t.Error("Markdown should contain the label filter")
+	}
+
+	var html bytes.Buffer
+	if err := RenderHTML(&html, report, nil); err != nil {
+		t.Fatalf("RenderHTML failed: %v", err)
+	}
+	if !strings.Contains(html.String(), `id="labelActivityChart"`) {
+		t.Error("HTML should contain label chart")
+	}
+}
+
+func TestRenderHTML_NoLabels(t *testing.T) {
+	report := &pr.Report{
+		StartDate: time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST),
+		EndDate:   time.Date(2025, 1, 7, 0, 0, 0, 0, timezone.JST),
+		Days: []pr.DailyPRs{
+			{
+				Date:   time.Date(2025, 1, 3, 0, 0, 0, 0, timezone.JST),
+				Opened: []github.PullRequest{{Title: "PR", State: "open"}},
+			},
+		},
+	}
+
+	var html bytes.Buffer
+	if err := RenderHTML(&html, report, nil); err != nil {
+		t.Fatalf("RenderHTML failed: %v", err)
+	}
+	if strings.Contains(html.String(), `id="labelActivityChart"`) {
+		t.Error("HTML should not contain label chart without labels")
+	}
+}
+
+func TestRender_MultipleOrgs(t *testing.T) {
+	report := &pr.Report{
+		GeneratedAt: time.Date(2025, 1, 15, 10, 30, 0, 0, timezone.JST),
+		StartDate:   time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST),
+		EndDate:     time.Date(2025, 1, 7, 0, 0, 0, 0, timezone.JST),
+		Orgs:        []string{"org-a", "org-b"},
+		Days: []pr.DailyPRs{
+			{
+				Date: time.Date(2025, 1, 3, 0, 0, 0, 0, timezone.JST),
+				Opened: []github.PullRequest{
+					{Title: "PR A", Repository: "org-a/app", State: "open"},
+					{Title: "PR B", Repository: "org-b/app", State: "open"},
+				},
+				Merged: []github.PullRequest{
+					{Title: "PR A2", Repository: "org-a/app", State: "merged"},
+				},
+			},
+		},
+	}
+
+	stats := calcOrgStats(report)
+	want := []OrgStat{
+		{Org: "org-a", OpenedCount: 1, MergedCount: 1},
+		{Org: "org-b", OpenedCount: 1},
+	}
+	if len(stats) != len(want) {
+		t.Fatalf("len(OrgStats): got %d, want %d", len(stats), len(want))
+	}
+	for i, s := range stats {
+		if s != want[i] {
+			t.Errorf("OrgStats[%d]: got %+v, want %+v", i, s, want[i])
+		}
+	}
+
+	// 同名リポジトリでもOrganizationごとに区別される
+	if repos := calcRepoStats(report); len(repos) != 1 || repos[0].Repository != "org-a/app" {
+		t.Errorf("RepoStats: got %+v, want only org-a/app", repos)
+	}
+
+	var md bytes.Buffer
+	if err := RenderMarkdown(&md, report, nil); err != nil {
+		t.Fatalf("RenderMarkdown failed: %v", err)
+	}
+	if !strings.Contains(md.String(), "Organization: org-a, org-b") {
+		t.Error("Markdown should list all organizations")
+	}
+	if !strings.Contains(md.String(), "| org-a | 1 | 0 | 1 | 0 | 0 |") {
+		t.Errorf("Markdown should contain per-org breakdown, got:\n%s", md.String())
+	}
+
+	var html bytes.Buffer
+	if err := RenderHTML(&html, report, nil); err != nil {
+		t.Fatalf("RenderHTML failed: %v", err)
+	}
+	if !strings.Contains(html.String(), "@org-a, @org-b") {
+		t.Error("HTML header should list all organizations")
+	}
+	if !strings.Contains(html.String(), `class="org-table"`) {
+		t.Error("HTML should contain per-org breakdown")
+	}
+}
+
+func TestCalcOrgStats_SingleOrg(t *testing.T) {
+	report := &pr.Report{Orgs: []string{"test-org"}}
+	if stats := calcOrgStats(report); stats != nil {
+		t.Errorf("OrgStats: got %+v, want nil for a single org", stats)
+	}
+}
+
+func TestCalcOrgStats_CaseInsensitive(t *testing.T) {
+	report := &pr.Report{
+		Orgs: []string{"MyOrg", "other"},
+		Days: []pr.DailyPRs{
+			{
+				Date: time.Date(2025, 1, 3, 0, 0, 0, 0, timezone.JST),
+				Merged: []github.PullRequest{
+					{Title: "PR A", Repository: "myorg/app", State: "merged"},
+					{Title: "PR B", Repository: "MYORG/api", State: "merged"},
+				},
+			},
+		},
+	}
+
+	stats := calcOrgStats(report)
+	want := []OrgStat{
+		{Org: "MyOrg", MergedCount: 2},
+		{Org: "other"},
+	}
+	if len(stats) != len(want) {
+		t.Fatalf("OrgStats: got %+v, want %+v", stats, want)
+	}
+	for i, s := range stats {
+		if s != want[i] {
+			t.Errorf("OrgStats[%d]: got %+v, want %+v", i, s, want[i])
+		}
+	}
+}
+
+func TestRender_Timezone(t *testing.T) {
+	report := &pr.Report{
+		GeneratedAt: time.Date(2025, 1, 15, 10, 30, 0, 0, timezone.JST),
+		StartDate:   time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST),
+		EndDate:     time.Date(2025, 1, 7, 0, 0, 0, 0, timezone.JST),
+		Orgs:        []string{"test-org"},
+		Timezone:    "America/New_York",
+	}
+
+	var md bytes.Buffer
+	if err := RenderMarkdown(&md, report, nil); err != nil {
+		t.Fatalf("RenderMarkdown failed: %v", err)
+	}
+	if !strings.Contains(md.String(), "Timezone: America/New_York\n") {
+		t.Errorf("Markdown should contain the timezone, got:\n%s", md.String())
+	}
+
+	var html bytes.Buffer
+	if err := RenderHTML(&html, report, nil); err != nil {
+		t.Fatalf("RenderHTML failed: %v", err)
+	}
+	if !strings.Contains(html.String(), "Timezone: America/New_York") {
+		t.Error("HTML should contain the timezone")
+	}
+}
+
+func TestCalcSummaryTrend(t *testing.T) {
+	reportWithMerged := func(n int) *pr.Report {
+		merged := make([]github.PullRequest, n)
+		return &pr.Report{Days: []pr.DailyPRs{{Date: time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST), Merged: merged}}}
+	}
+	current := Summary{MergedCount: 6, OpenedCount: 1}
+
+	// 新しい順: 直前が 4、その前が取得失敗、さらに前が 2
+	trend := calcSummaryTrend(current, []*pr.Report{reportWithMerged(4), nil, reportWithMerged(2)})
+
+	if trend.Periods != 2 || !trend.HasTrend() {
+		t.Fatalf("Periods: got %d, want 2", trend.Periods)
+	}
+	merged := trend.Merged
+	if want := []int{2, 4, 6}; len(merged.Values) != 3 || merged.Values[0] != want[0] || merged.Values[1] != want[1] || merged.Values[2] != want[2] {
+		t.Errorf("Merged.Values: got %v, want %v", merged.Values, want)
+	}
+	if merged.Average != 3 || merged.Min != 2 || merged.Max != 4 {
+		t.Errorf("Merged: got avg=%v min=%d max=%d, want 3/2/4", merged.Average, merged.Min, merged.Max)
+	}
+	if merged.DiffFromAverage() != 3 {
+		t.Errorf("Merged.DiffFromAverage: got %v, want 3", merged.DiffFromAverage())
+	}
+	if trend.Opened.DiffFromAverage() != 1 {
+		t.Errorf("Opened.DiffFromAverage: got %v, want 1", trend.Opened.DiffFromAverage())
+	}
+
+	if trend := calcSummaryTrend(current, []*pr.Report{reportWithMerged(4)}); trend.HasTrend() {
+		t.Error("a single previous period should not be shown as a trend")
+	}
+	if trend := calcSummaryTrend(current, nil); trend.Periods != 0 {
+		t.Errorf("Periods without history: got %d, want 0", trend.Periods)
+	}
+}
+
+func TestTrendStat_SparklinePoints(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		want   string
+	}{
+		{"rising", []int{0, 5, 10}, "0.0,10.0 5.0,5.0 10.0,0.0"},
+		{"flat", []int{3, 3}, "0.0,5.0 10.0,5.0"},
+		{"single", []int{7}, "0.0,5.0"},
+		{"empty", nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := (TrendStat{Values: tt.values}).SparklinePoints(10, 10); got != tt.want {
+				t.Errorf("SparklinePoints: got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderHTML_Trend(t *testing.T) {
+	day := func(merged int) []pr.DailyPRs {
+		return []pr.DailyPRs{{Date: time.Date(2025, 1, 10, 0, 0, 0, 0, timezone.JST), Merged: make([]github.PullRequest, merged)}}
+	}
+	report := &pr.Report{
+		StartDate: time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST),
+		EndDate:   time.Date(2025, 1, 31, 0, 0, 0, 0, timezone.JST),
+		Days:      day(5),
+	}
+	history := []*pr.Report{{Days: day(3)}, {Days: day(1)}}
+
+	var buf bytes.Buffer
+	if err := RenderHTML(&buf, report, history[0], WithHistory(history...)); err != nil {
+		t.Fatalf("RenderHTML failed: %v", err)
+	}
+	html := buf.String()
+	if !strings.Contains(html, `class="sparkline"`) {
+		t.Error("HTML should contain sparklines")
+	}
+	if !strings.Contains(html, "▲ avg 2.0") {
+		t.Error("HTML should compare merged count against the average")
+	}
+
+	buf.Reset()
+	if err := RenderHTML(&buf, report, history[0]); err != nil {
+		t.Fatalf("RenderHTML failed: %v", err)
+	}
+	if strings.Contains(buf.String(), `class="sparkline"`) {
+		t.Error("HTML should not contain sparklines without history")
+	}
+}
+
+func TestRenderHTML_BaselineLabel(t *testing.T) {
+	report := &pr.Report{
+		StartDate: time.Date(2025, 12, 1, 0, 0, 0, 0, timezone.JST),
+		EndDate:   time.Date(2025, 12, 31, 0, 0, 0, 0, timezone.JST),
+		Days: []pr.DailyPRs{
+			{Date: time.Date(2025, 12, 10, 0, 0, 0, 0, timezone.JST), Merged: []github.PullRequest{{}, {}}},
+		},
+	}
+	yearAgo := &pr.Report{
+		StartDate: time.Date(2024, 12, 1, 0, 0, 0, 0, timezone.JST),
+		EndDate:   time.Date(2024, 12, 31, 0, 0, 0, 0, timezone.JST),
+	}
+
+	var buf bytes.Buffer
+	if err := RenderHTML(&buf, report, yearAgo, WithBaselineLabel("same period last year")); err != nil {
+		t.Fatalf("RenderHTML failed: %v", err)
+	}
+	html := buf.String()
+	if !strings.Contains(html, "Compared with same period last year (2024/12/01 〜 2024/12/31)") {
+		t.Error("HTML should describe the comparison baseline")
+	}
+	if !strings.Contains(html, `title="vs same period last year (2024/12/01 〜 2024/12/31)"`) {
+		t.Error("diff badges should be labeled with the baseline")
+	}
+
+	buf.Reset()
+	if err := RenderHTML(&buf, report, yearAgo); err != nil {
+		t.Fatalf("RenderHTML failed: %v", err)
+	}
+	if !strings.Contains(buf.String(), "Compared with previous period") {
+		t.Error("baseline should default to the previous period")
+	}
+}
+
+func TestCalcBusinessDayStat(t *testing.T) {
+	// 2025-01-01 (Wed) 〜 2025-01-07 (Tue), 2025-01-01 is a holiday
+	report := &pr.Report{
+		StartDate: time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST),
+		EndDate:   time.Date(2025, 1, 7, 0, 0, 0, 0, timezone.JST),
+		Days: []pr.DailyPRs{
+			{Date: time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST), Opened: []github.PullRequest{{}, {}}},
+			{Date: time.Date(2025, 1, 2, 0, 0, 0, 0, timezone.JST), Opened: []github.PullRequest{{}}, Merged: []github.PullRequest{{}}},
+			{Date: time.Date(2025, 1, 4, 0, 0, 0, 0, timezone.JST), Reviewed: []github.PullRequest{{}}},
+			{Date: time.Date(2025, 1, 6, 0, 0, 0, 0, timezone.JST), Opened: []github.PullRequest{{}}, Reviewed: []github.PullRequest{{}}},
+		},
+	}
+	cal := holiday.New(nil, []holiday.Holiday{{Date: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), Name: "New Year's Day"}})
+
+	stats := calcDailyStats(report)
+	markBusinessDays(stats, cal)
+	b := calcBusinessDayStat(stats)
+
+	// Business days: Thu 2, Fri 3, Mon 6, Tue 7 (the holiday and the weekend are excluded)
+	if b.BusinessDays != 4 {
+		t.Errorf("BusinessDays: got %d, want 4", b.BusinessDays)
+	}
+	if b.QuietDays != 2 {
+		t.Errorf("QuietDays: got %d, want 2", b.QuietDays)
+	}
+	if b.OpenedPerDay != 0.5 || b.MergedPerDay != 0.25 || b.ReviewedPerDay != 0.25 {
+		t.Errorf("per day: got %.2f / %.2f / %.2f, want 0.50 / 0.25 / 0.25", b.OpenedPerDay, b.MergedPerDay, b.ReviewedPerDay)
+	}
+	if len(b.Holidays) != 1 || b.Holidays[0] != (HolidayStat{Date: "2025-01-01", Name: "New Year's Day"}) {
+		t.Errorf("Holidays: got %v", b.Holidays)
+	}
+
+	nonBusinessDays := calcNonBusinessDays(stats)
+	want := map[string]string{"2025-01-01": "New Year's Day", "2025-01-04": "", "2025-01-05": ""}
+	if !reflect.DeepEqual(nonBusinessDays, want) {
+		t.Errorf("NonBusinessDays: got %v, want %v", nonBusinessDays, want)
+	}
+}
+
+func TestRender_BusinessDays(t *testing.T) {
+	report := &pr.Report{
+		Orgs:      []string{"test-org"},
+		StartDate: time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST),
+		EndDate:   time.Date(2025, 1, 7, 0, 0, 0, 0, timezone.JST),
+		Days: []pr.DailyPRs{
+			{Date: time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST), Opened: []github.PullRequest{{Title: "Hotfix", Repository: "repo", State: "open"}}},
+			{Date: time.Date(2025, 1, 2, 0, 0, 0, 0, timezone.JST), Merged: []github.PullRequest{{Title: "Feature", Repository: "repo", State: "merged"}}},
+		},
+	}
+	cal := holiday.New(nil, []holiday.Holiday{{Date: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), Name: "New Year's Day"}})
+
+	var md bytes.Buffer
+	if err := RenderMarkdown(&md, report, nil, WithBusinessCalendar(cal)); err != nil {
+		t.Fatalf("RenderMarkdown failed: %v", err)
+	}
+	for _, want := range []string{
+		"Business days: 4 (holidays: 2025-01-01 New Year's Day)",
+		"Per business day: 0.0 opened / 0.2 merged / 0.0 reviewed (3 quiet days)",
+		"## 2025-01-01 (Wed, New Year's Day)",
+		"## 2025-01-02 (Thu)",
+	} {
+		if !strings.Contains(md.String(), want) {
+			t.Errorf("Markdown should contain %q", want)
+		}
+	}
+
+	var html bytes.Buffer
+	if err := RenderHTML(&html, report, nil, WithBusinessCalendar(cal)); err != nil {
+		t.Fatalf("RenderHTML failed: %v", err)
+	}
+	for _, want := range []string{
+		"4 business days",
+		"0.2 merged",
+		`<span title="2025-01-01">New Year&#39;s Day</span>`,
+		`"2025-01-01":"New Year's Day"`,
+	} {
+		if !strings.Contains(html.String(), want) {
+			t.Errorf("HTML should contain %q", want)
+		}
+	}
+
+	// Without a calendar, weekends are the only non-business days
+	md.Reset()
+	if err := RenderMarkdown(&md, report, nil); err != nil {
+		t.Fatalf("RenderMarkdown failed: %v", err)
+	}
+	if !strings.Contains(md.String(), "Business days: 5\n") {
+		t.Error("Markdown should count weekdays as business days by default")
+	}
+}
@@ -0,0 +1,81 @@
+package render
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/taikicoco/shiraberu/internal/github"
+	"github.com/taikicoco/shiraberu/internal/pr"
+)
+
+// JSONSchemaVersion は JSON 出力のスキーマバージョン。
+// フィールドの追加では変更せず、削除や意味の変更など互換性のない変更を行う場合に上げる（docs/json-schema.md）
+const JSONSchemaVersion = 1
+
+// JSONReport は JSON 出力のトップレベルのデータ
+type JSONReport struct {
+	SchemaVersion int             `json:"schemaVersion"`
+	Report        *pr.Report      `json:"report"`
+	Summary       Summary         `json:"summary"`
+	SummaryDiff   SummaryDiff     `json:"summaryDiff"`
+	DailyStats    []DailyStat     `json:"dailyStats"`
+	WeeklyStats   []WeeklyStat    `json:"weeklyStats"`
+	MonthlyStats  []MonthlyStat   `json:"monthlyStats"`
+	RepoStats     []RepoStat      `json:"repoStats"`
+	BusinessDays  BusinessDayStat `json:"businessDays"`
+	// Previous は比較対象の期間のレポート（取得できなかった場合は null）
+	Previous *pr.Report `json:"previous"`
+}
+
+// RenderJSON はレポートと集計結果を JSONSchemaVersion のスキーマで出力する
+func RenderJSON(w io.Writer, report *pr.Report, previousReport *pr.Report, opts ...Option) error {
+	o := newOptions(opts)
+	summary := calcSummary(report)
+	dailyStats := calcDailyStats(report)
+	markBusinessDays(dailyStats, o.businessCalendar)
+	summaryDiff := calcSummaryDiff(summary, previousReport)
+	summaryDiff.Label = o.baselineLabel
+
+	data := JSONReport{
+		SchemaVersion: JSONSchemaVersion,
+		Report:        withEmptyDays(report),
+		Summary:       summary,
+		SummaryDiff:   summaryDiff,
+		DailyStats:    dailyStats,
+		WeeklyStats:   calcWeeklyStats(report),
+		MonthlyStats:  calcMonthlyStats(report),
+		RepoStats:     calcRepoStats(report),
+		BusinessDays:  calcBusinessDayStat(dailyStats),
+		Previous:      withEmptyDays(previousReport),
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(data)
+}
+
+// withEmptyDays は PR のない期間や分類でも days と各日の PR のリストが null ではなく空配列として出力されるようにする
+func withEmptyDays(report *pr.Report) *pr.Report {
+	if report == nil {
+		return nil
+	}
+	r := *report
+	r.Days = make([]pr.DailyPRs, len(report.Days))
+	for i, day := range report.Days {
+		day.Opened = nonNilPRs(day.Opened)
+		day.Draft = nonNilPRs(day.Draft)
+		day.Merged = nonNilPRs(day.Merged)
+		day.Closed = nonNilPRs(day.Closed)
+		day.Reviewed = nonNilPRs(day.Reviewed)
+		r.Days[i] = day
+	}
+	return &r
+}
+
+// nonNilPRs は nil の PR のリストを空のリストに置き換える
+func nonNilPRs(prs []github.PullRequest) []github.PullRequest {
+	if prs == nil {
+		return []github.PullRequest{}
+	}
+	return prs
+}
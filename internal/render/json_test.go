@@ -0,0 +1,135 @@
+package render
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/taikicoco/shiraberu/internal/github"
+	"github.com/taikicoco/shiraberu/internal/pr"
+	"github.com/taikicoco/shiraberu/internal/timezone"
+)
+
+func TestRenderJSON(t *testing.T) {
+	mergedAt := time.Date(2025, 1, 2, 15, 0, 0, 0, timezone.JST)
+	report := &pr.Report{
+		GeneratedAt: time.Date(2025, 1, 7, 9, 0, 0, 0, timezone.JST),
+		StartDate:   time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST),
+		EndDate:     time.Date(2025, 1, 7, 0, 0, 0, 0, timezone.JST),
+		Orgs:        []string{"test-org"},
+		Username:    "testuser",
+		Days: []pr.DailyPRs{
+			{
+				Date: time.Date(2025, 1, 2, 0, 0, 0, 0, timezone.JST),
+				Merged: []github.PullRequest{
+					{Title: "Feature", URL: "https://github.com/test-org/repo/pull/1", Repository: "test-org/repo", State: "merged", MergedAt: &mergedAt, Additions: 10, Deletions: 2, Labels: []string{"feature"}},
+				},
+				Reviewed: []github.PullRequest{
+					{Title: "Fix", Repository: "test-org/other", State: "open", Reviews: []github.Review{{State: github.ReviewApproved}}},
+				},
+			},
+		},
+	}
+	previous := &pr.Report{
+		StartDate: time.Date(2024, 12, 25, 0, 0, 0, 0, timezone.JST),
+		EndDate:   time.Date(2024, 12, 31, 0, 0, 0, 0, timezone.JST),
+	}
+
+	var buf bytes.Buffer
+	if err := RenderJSON(&buf, report, previous, WithBaselineLabel("previous period")); err != nil {
+		t.Fatalf("RenderJSON failed: %v", err)
+	}
+
+	var got JSONReport
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("output is not valid JSON: %v\n%s", err, buf.String())
+	}
+
+	if got.SchemaVersion != JSONSchemaVersion {
+		t.Errorf("SchemaVersion: got %d, want %d", got.SchemaVersion, JSONSchemaVersion)
+	}
+	if got.Report.Username != "testuser" || len(got.Report.Days) != 1 || got.Report.Days[0].Merged[0].Title != "Feature" {
+		t.Errorf("Report: got %+v", got.Report)
+	}
+	if m := got.Report.Days[0].Merged[0]; m.MergedAt == nil || !m.MergedAt.Equal(mergedAt) {
+		t.Errorf("MergedAt: got %v, want %v", m.MergedAt, mergedAt)
+	}
+	if got.Summary.MergedCount != 1 || got.Summary.ApprovedCount != 1 || got.Summary.Additions != 10 {
+		t.Errorf("Summary: got %+v", got.Summary)
+	}
+	if !got.SummaryDiff.HasPrevious || got.SummaryDiff.MergedDiff != 1 || got.SummaryDiff.Label != "previous period" {
+		t.Errorf("SummaryDiff: got %+v", got.SummaryDiff)
+	}
+	if len(got.DailyStats) != 7 || len(got.WeeklyStats) == 0 || len(got.MonthlyStats) != 1 {
+		t.Errorf("stats: got %d daily, %d weekly, %d monthly", len(got.DailyStats), len(got.WeeklyStats), len(got.MonthlyStats))
+	}
+	if len(got.RepoStats) != 1 || got.RepoStats[0] != (RepoStat{Repository: "test-org/repo", Count: 1}) {
+		t.Errorf("RepoStats: got %+v", got.RepoStats)
+	}
+	if got.BusinessDays.BusinessDays != 5 {
+		t.Errorf("BusinessDays: got %d, want 5", got.BusinessDays.BusinessDays)
+	}
+	if got.Previous == nil || got.Previous.Days == nil {
+		t.Errorf("Previous: days should be an empty array, got %+v", got.Previous)
+	}
+
+	// Field names are part of the schema
+	for _, key := range []string{`"schemaVersion": 1`, `"openedCount"`, `"mergedAt"`, `"repository": "test-org/repo"`, `"hasPrevious": true`, `"days": []`, `"opened": []`, `"closed": []`} {
+		if !bytes.Contains(buf.Bytes(), []byte(key)) {
+			t.Errorf("JSON should contain %s", key)
+		}
+	}
+}
+
+func TestRenderJSON_NoPrevious(t *testing.T) {
+	report := &pr.Report{
+		StartDate: time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST),
+		EndDate:   time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST),
+	}
+
+	var buf bytes.Buffer
+	if err := RenderJSON(&buf, report, nil); err != nil {
+		t.Fatalf("RenderJSON failed: %v", err)
+	}
+	if !bytes.Contains(buf.Bytes(), []byte(`"previous": null`)) {
+		t.Error("previous should be null when there is no comparison period")
+	}
+	if report.Days != nil {
+		t.Error("RenderJSON should not modify the report")
+	}
+}
+
+func TestRenderJSON_EmptyPRLists(t *testing.T) {
+	report := &pr.Report{
+		StartDate: time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST),
+		EndDate:   time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST),
+		Days: []pr.DailyPRs{
+			{Date: time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST), Merged: []github.PullRequest{{Title: "Feature", Repository: "test-org/repo"}}},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := RenderJSON(&buf, report, nil); err != nil {
+		t.Fatalf("RenderJSON failed: %v", err)
+	}
+	var got struct {
+		Report struct {
+			Days []map[string]json.RawMessage `json:"days"`
+		} `json:"report"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("output is not valid JSON: %v", err)
+	}
+	if len(got.Report.Days) != 1 {
+		t.Fatalf("days: got %d, want 1", len(got.Report.Days))
+	}
+	for _, key := range []string{"opened", "draft", "closed", "reviewed"} {
+		if v := string(got.Report.Days[0][key]); v != "[]" {
+			t.Errorf("%s: got %s, want []", key, v)
+		}
+	}
+	if report.Days[0].Opened != nil {
+		t.Error("RenderJSON should not modify the report")
+	}
+}
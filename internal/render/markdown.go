@@ -1,8 +1,10 @@
 package render
 
 import (
+	"encoding/base64"
 	"fmt"
 	"io"
+	"slices"
 	"strings"
 	"time"
 
@@ -17,63 +19,351 @@ func formatPeriod(start, end time.Time) string {
 	return start.Format("2006/01/02") + " 〜 " + end.Format("2006/01/02")
 }
 
-func RenderMarkdown(w io.Writer, report *pr.Report) error {
+// MarkdownSection は Markdown 出力で表示を切り替えられるセクション
+type MarkdownSection string
+
+const (
+	SectionSummary MarkdownSection = "summary" // 集計と比較対象との差分
+	SectionCharts  MarkdownSection = "charts"  // SVG グラフ（WithMarkdownCharts を設定した場合のみ）
+	SectionOrgs    MarkdownSection = "orgs"    // Organization別の集計（複数Organizationの場合のみ）
+	SectionRepos   MarkdownSection = "repos"   // リポジトリ別のマージ数
+	SectionWeekly  MarkdownSection = "weekly"  // 週別の集計（2週以上の場合のみ）
+	SectionMonthly MarkdownSection = "monthly" // 月別の集計（2ヶ月以上の場合のみ）
+	SectionDays    MarkdownSection = "days"    // 日別のPR一覧
+)
+
+// MarkdownSections は Markdown 出力のセクション（出力順）
+var MarkdownSections = []MarkdownSection{SectionSummary, SectionCharts, SectionOrgs, SectionRepos, SectionWeekly, SectionMonthly, SectionDays}
+
+// ParseMarkdownSections はカンマ区切りのセクション名（例: summary,repos）を解釈する。空の場合は全てのセクションを返す
+func ParseMarkdownSections(s string) ([]MarkdownSection, error) {
+	var sections []MarkdownSection
+	for _, name := range strings.Split(s, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		section := MarkdownSection(name)
+		if !slices.Contains(MarkdownSections, section) {
+			valid := make([]string, len(MarkdownSections))
+			for i, s := range MarkdownSections {
+				valid[i] = string(s)
+			}
+			return nil, fmt.Errorf("unknown section %q (want %s)", name, strings.Join(valid, ", "))
+		}
+		sections = append(sections, section)
+	}
+	if len(sections) == 0 {
+		return MarkdownSections, nil
+	}
+	return sections, nil
+}
+
+func RenderMarkdown(w io.Writer, report *pr.Report, previousReport *pr.Report, opts ...Option) error {
+	o := newOptions(opts)
+	dailyStats := calcDailyStats(report)
+	markBusinessDays(dailyStats, o.businessCalendar)
+	businessDays := calcBusinessDayStat(dailyStats)
+
+	if t := o.template; t != nil && t.markdown != nil {
+		return t.markdown.ExecuteTemplate(w, t.markdownName, newMarkdownData(report, previousReport, dailyStats, businessDays, o))
+	}
+
 	periodLabel := formatPeriod(report.StartDate, report.EndDate)
 
 	fmt.Fprintf(w, "# PR Log (%s)\n\n", periodLabel)
-	fmt.Fprintf(w, "Organization: %s\n", report.Org)
+	fmt.Fprintf(w, "Organization: %s\n", strings.Join(report.Orgs, ", "))
+	if report.Host != "" {
+		fmt.Fprintf(w, "Host: %s\n", report.Host)
+	}
+	if !report.LabelFilter.IsEmpty() {
+		fmt.Fprintf(w, "Labels: %s\n", report.LabelFilter)
+	}
+	if report.Timezone != "" {
+		fmt.Fprintf(w, "Timezone: %s\n", report.Timezone)
+	}
+	fmt.Fprintf(w, "Business days: %d", businessDays.BusinessDays)
+	if len(businessDays.Holidays) > 0 {
+		holidays := make([]string, 0, len(businessDays.Holidays))
+		for _, h := range businessDays.Holidays {
+			holidays = append(holidays, h.Date+" "+h.Name)
+		}
+		fmt.Fprintf(w, " (holidays: %s)", strings.Join(holidays, ", "))
+	}
+	fmt.Fprintln(w)
 	fmt.Fprintf(w, "Generated: %s\n\n", report.GeneratedAt.Format("2006-01-02 15:04"))
 
+	for _, warning := range report.Warnings {
+		fmt.Fprintf(w, "> ⚠ %s\n", warning)
+	}
+	if len(report.Warnings) > 0 {
+		fmt.Fprintln(w)
+	}
+
 	if len(report.Days) == 0 {
 		fmt.Fprintln(w, "No pull requests found.")
 		return nil
 	}
 
-	weekdays := []string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+	for _, section := range o.markdownSections {
+		switch section {
+		case SectionSummary:
+			summary := calcSummary(report)
+			summaryDiff := calcSummaryDiff(summary, previousReport)
+			summaryDiff.Label = o.baselineLabel
+			writeMarkdownSummary(w, summary, summaryDiff, businessDays)
+		case SectionCharts:
+			writeMarkdownCharts(w, report, o)
+		case SectionOrgs:
+			writeMarkdownOrgs(w, calcOrgStats(report))
+		case SectionRepos:
+			writeMarkdownRepos(w, calcRepoStats(report))
+		case SectionWeekly:
+			weeklyStats := calcWeeklyStats(report)
+			if len(weeklyStats) > 1 {
+				rows := make([]markdownPeriodRow, len(weeklyStats))
+				for i, s := range weeklyStats {
+					rows[i] = markdownPeriodRow{s.Week, s.OpenedCount, s.DraftCount, s.MergedCount, s.ClosedCount, s.ReviewedCount}
+				}
+				writeMarkdownPeriodTable(w, "Weekly", "Week", rows)
+			}
+		case SectionMonthly:
+			monthlyStats := calcMonthlyStats(report)
+			if len(monthlyStats) > 1 {
+				rows := make([]markdownPeriodRow, len(monthlyStats))
+				for i, s := range monthlyStats {
+					rows[i] = markdownPeriodRow{s.Month, s.OpenedCount, s.DraftCount, s.MergedCount, s.ClosedCount, s.ReviewedCount}
+				}
+				writeMarkdownPeriodTable(w, "Monthly", "Month", rows)
+			}
+		case SectionDays:
+			writeMarkdownDays(w, report, businessDays.Holidays)
+		}
+	}
 
-	for _, day := range report.Days {
-		weekday := weekdays[day.Date.Weekday()]
-		fmt.Fprintf(w, "## %s (%s)\n\n", day.Date.Format("2006-01-02"), weekday)
+	return nil
+}
 
-		if len(day.Opened) > 0 {
-			fmt.Fprintln(w, "### Opened")
-			for _, p := range day.Opened {
-				writePRLine(w, p)
-			}
-			fmt.Fprintln(w)
+// newMarkdownData はユーザー指定の Markdown テンプレートに渡すデータを組み立てる
+func newMarkdownData(report, previousReport *pr.Report, dailyStats []DailyStat, businessDays BusinessDayStat, o *options) MarkdownData {
+	summary := calcSummary(report)
+	summaryDiff := calcSummaryDiff(summary, previousReport)
+	summaryDiff.Label = o.baselineLabel
+	return MarkdownData{
+		Report:          report,
+		PreviousReport:  previousReport,
+		Summary:         summary,
+		SummaryDiff:     summaryDiff,
+		SummaryTrend:    calcSummaryTrend(summary, o.history),
+		DailyStats:      dailyStats,
+		WeeklyStats:     calcWeeklyStats(report),
+		MonthlyStats:    calcMonthlyStats(report),
+		QuarterlyStats:  calcQuarterlyStats(report),
+		RepoStats:       calcRepoStats(report),
+		LabelStats:      calcLabelStats(report),
+		OrgStats:        calcOrgStats(report),
+		BusinessDayStat: businessDays,
+		PeriodLabel:     formatPeriod(report.StartDate, report.EndDate),
+	}
+}
+
+// writeMarkdownSummary は集計と比較対象の期間との差分の表を出力する
+func writeMarkdownSummary(w io.Writer, summary Summary, diff SummaryDiff, businessDays BusinessDayStat) {
+	fmt.Fprintln(w, "## Summary")
+	fmt.Fprintln(w)
+
+	rows := []struct {
+		label string
+		count int
+		diff  int
+	}{
+		{"Opened", summary.OpenedCount, diff.OpenedDiff},
+		{"Draft", summary.DraftCount, diff.DraftDiff},
+		{"Merged", summary.MergedCount, diff.MergedDiff},
+		{"Closed", summary.ClosedCount, diff.ClosedDiff},
+		{"Reviewed", summary.ReviewedCount, diff.ReviewedDiff},
+	}
+	if diff.HasPrevious {
+		fmt.Fprintf(w, "| | Count | vs %s (%s) |\n", diff.Label, diff.Period)
+		fmt.Fprintln(w, "|---|---:|---:|")
+		for _, r := range rows {
+			fmt.Fprintf(w, "| %s | %d | %s |\n", r.label, r.count, formatDiff(r.diff))
+		}
+	} else {
+		fmt.Fprintln(w, "| | Count |")
+		fmt.Fprintln(w, "|---|---:|")
+		for _, r := range rows {
+			fmt.Fprintf(w, "| %s | %d |\n", r.label, r.count)
 		}
+	}
+	fmt.Fprintln(w)
 
-		if len(day.Draft) > 0 {
-			fmt.Fprintln(w, "### Draft")
-			for _, p := range day.Draft {
-				writePRLine(w, p)
-			}
-			fmt.Fprintln(w)
+	fmt.Fprintf(w, "Merged changes: +%d / -%d\n\n", summary.Additions, summary.Deletions)
+	if summary.ReviewedCount > 0 {
+		fmt.Fprintf(w, "Reviews: %d approved / %d changes requested / %d commented\n\n",
+			summary.ApprovedCount, summary.ChangesRequestedCount, summary.CommentedCount)
+	}
+	if businessDays.BusinessDays > 0 {
+		fmt.Fprintf(w, "Per business day: %.1f opened / %.1f merged / %.1f reviewed (%d quiet days)\n\n",
+			businessDays.OpenedPerDay, businessDays.MergedPerDay, businessDays.ReviewedPerDay, businessDays.QuietDays)
+	}
+}
+
+// writeMarkdownCharts は SVG グラフへの参照（または data URI で埋め込んだ画像）を出力する
+func writeMarkdownCharts(w io.Writer, report *pr.Report, o *options) {
+	if o.chartMode == ChartNone {
+		return
+	}
+	charts := RenderCharts(report, WithBusinessCalendar(o.businessCalendar))
+	if len(charts) == 0 {
+		return
+	}
+
+	fmt.Fprintln(w, "## Charts")
+	fmt.Fprintln(w)
+	for _, c := range charts {
+		src := c.FileName(o.chartPrefix)
+		if o.chartMode == ChartInline {
+			src = "data:image/svg+xml;base64," + base64.StdEncoding.EncodeToString(c.SVG)
 		}
+		fmt.Fprintf(w, "![%s](%s)\n\n", c.Title, src)
+	}
+}
 
-		if len(day.Merged) > 0 {
-			fmt.Fprintln(w, "### Merged")
-			for _, p := range day.Merged {
-				writePRLine(w, p)
-			}
-			fmt.Fprintln(w)
+// writeMarkdownOrgs はOrganization別の集計の表を出力する。単一Organizationの場合は何も出力しない
+func writeMarkdownOrgs(w io.Writer, orgStats []OrgStat) {
+	if len(orgStats) == 0 {
+		return
+	}
+	fmt.Fprintln(w, "## Organizations")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "| Organization | Opened | Draft | Merged | Closed | Reviewed |")
+	fmt.Fprintln(w, "|---|---:|---:|---:|---:|---:|")
+	for _, s := range orgStats {
+		fmt.Fprintf(w, "| %s | %d | %d | %d | %d | %d |\n",
+			s.Org, s.OpenedCount, s.DraftCount, s.MergedCount, s.ClosedCount, s.ReviewedCount)
+	}
+	fmt.Fprintln(w)
+}
+
+// writeMarkdownRepos はリポジトリ別のマージ数の表を出力する
+func writeMarkdownRepos(w io.Writer, repoStats []RepoStat) {
+	if len(repoStats) == 0 {
+		return
+	}
+	fmt.Fprintln(w, "## Repositories")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "| Repository | Merged |")
+	fmt.Fprintln(w, "|---|---:|")
+	for _, s := range repoStats {
+		fmt.Fprintf(w, "| %s | %d |\n", s.Repository, s.Count)
+	}
+	fmt.Fprintln(w)
+}
+
+// markdownPeriodRow は週別・月別の表の1行
+type markdownPeriodRow struct {
+	label                                   string
+	opened, draft, merged, closed, reviewed int
+}
+
+// writeMarkdownPeriodTable は週別・月別の集計の表を出力する
+func writeMarkdownPeriodTable(w io.Writer, title, column string, rows []markdownPeriodRow) {
+	fmt.Fprintf(w, "## %s\n\n", title)
+	fmt.Fprintf(w, "| %s | Opened | Draft | Merged | Closed | Reviewed |\n", column)
+	fmt.Fprintln(w, "|---|---:|---:|---:|---:|---:|")
+	for _, r := range rows {
+		fmt.Fprintf(w, "| %s | %d | %d | %d | %d | %d |\n", r.label, r.opened, r.draft, r.merged, r.closed, r.reviewed)
+	}
+	fmt.Fprintln(w)
+}
+
+// writeMarkdownDays は日別のPR一覧を出力する。休日の見出しには休日名を付ける
+func writeMarkdownDays(w io.Writer, report *pr.Report, holidays []HolidayStat) {
+	holidayNames := make(map[string]string, len(holidays))
+	for _, h := range holidays {
+		holidayNames[h.Date] = h.Name
+	}
+
+	weekdays := []string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+
+	for _, day := range report.Days {
+		weekday := weekdays[day.Date.Weekday()]
+		dateStr := day.Date.Format("2006-01-02")
+		if name, ok := holidayNames[dateStr]; ok {
+			fmt.Fprintf(w, "## %s (%s, %s)\n\n", dateStr, weekday, name)
+		} else {
+			fmt.Fprintf(w, "## %s (%s)\n\n", dateStr, weekday)
 		}
 
-		if len(day.Reviewed) > 0 {
-			fmt.Fprintln(w, "### Reviewed")
-			for _, p := range day.Reviewed {
+		categories := []struct {
+			title string
+			prs   []github.PullRequest
+		}{
+			{"Opened", day.Opened},
+			{"Draft", day.Draft},
+			{"Merged", day.Merged},
+			{"Closed", day.Closed},
+			{"Reviewed", day.Reviewed},
+		}
+		for _, c := range categories {
+			if len(c.prs) == 0 {
+				continue
+			}
+			fmt.Fprintln(w, "### "+c.title)
+			for _, p := range c.prs {
 				writePRLine(w, p)
 			}
 			fmt.Fprintln(w)
 		}
 	}
+}
 
-	return nil
+// formatDiff は差分を符号付きで表示する (例: +3, -1, ±0)
+func formatDiff(n int) string {
+	if n == 0 {
+		return "±0"
+	}
+	return fmt.Sprintf("%+d", n)
 }
 
 func writePRLine(w io.Writer, p github.PullRequest) {
-	state := capitalize(p.State)
-	_, _ = fmt.Fprintf(w, "- [%s](%s) - %s (%s)\n", p.Title, p.URL, p.Repository, state)
+	line := fmt.Sprintf("- [%s](%s) - %s (%s)", p.Title, p.URL, p.Repository, capitalize(p.State))
+	for _, label := range p.Labels {
+		line += " " + markdownCode(label)
+	}
+	if len(p.Reviews) > 0 {
+		verdicts := make([]string, 0, len(p.Reviews))
+		for _, r := range p.Reviews {
+			verdicts = append(verdicts, reviewStateLabel(r.State))
+		}
+		line += " - " + strings.Join(verdicts, ", ")
+	}
+	_, _ = fmt.Fprintln(w, line)
+}
+
+// markdownCode は s をコードスパンにする。s に含まれるバッククォートの並びより長い区切りを使い、
+// 先頭や末尾がバッククォートの場合は区切りと繋がらないよう空白を挟む
+func markdownCode(s string) string {
+	longest, run := 0, 0
+	for _, r := range s {
+		if r != '`' {
+			run = 0
+			continue
+		}
+		run++
+		longest = max(longest, run)
+	}
+	fence := strings.Repeat("`", longest+1)
+	if strings.HasPrefix(s, "`") || strings.HasSuffix(s, "`") {
+		s = " " + s + " "
+	}
+	return fence + s + fence
+}
+
+// reviewStateLabel はレビュー状態の表示名を返す (例: changes_requested → Changes requested)
+func reviewStateLabel(state string) string {
+	return capitalize(strings.ReplaceAll(state, "_", " "))
 }
 
 func capitalize(s string) string {
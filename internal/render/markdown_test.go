@@ -0,0 +1,169 @@
+package render
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/taikicoco/shiraberu/internal/github"
+	"github.com/taikicoco/shiraberu/internal/pr"
+	"github.com/taikicoco/shiraberu/internal/timezone"
+)
+
+func TestParseMarkdownSections(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    []MarkdownSection
+		wantErr bool
+	}{
+		{"", MarkdownSections, false},
+		{"summary", []MarkdownSection{SectionSummary}, false},
+		{" Repos , summary ", []MarkdownSection{SectionRepos, SectionSummary}, false},
+		{"summary,charts", []MarkdownSection{SectionSummary, SectionCharts}, false},
+		{"summary,graphs", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseMarkdownSections(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseMarkdownSections(%q): expected error", tt.input)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseMarkdownSections(%q) failed: %v", tt.input, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseMarkdownSections(%q): got %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func markdownSectionsReport() (*pr.Report, *pr.Report) {
+	report := &pr.Report{
+		Orgs:      []string{"test-org"},
+		StartDate: time.Date(2025, 1, 20, 0, 0, 0, 0, timezone.JST),
+		EndDate:   time.Date(2025, 2, 9, 0, 0, 0, 0, timezone.JST),
+		Days: []pr.DailyPRs{
+			{
+				Date:   time.Date(2025, 2, 3, 0, 0, 0, 0, timezone.JST),
+				Merged: []github.PullRequest{{Title: "Feature", Repository: "test-org/api", State: "merged", Additions: 30, Deletions: 5}},
+			},
+			{
+				Date:   time.Date(2025, 1, 21, 0, 0, 0, 0, timezone.JST),
+				Opened: []github.PullRequest{{Title: "Refactor", Repository: "test-org/web", State: "open"}},
+				Merged: []github.PullRequest{
+					{Title: "Fix", Repository: "test-org/api", State: "merged", Additions: 10, Deletions: 2},
+					{Title: "Docs", Repository: "test-org/web", State: "merged"},
+				},
+			},
+		},
+	}
+	previous := &pr.Report{
+		StartDate: time.Date(2024, 12, 30, 0, 0, 0, 0, timezone.JST),
+		EndDate:   time.Date(2025, 1, 19, 0, 0, 0, 0, timezone.JST),
+		Days: []pr.DailyPRs{
+			{
+				Date:     time.Date(2025, 1, 10, 0, 0, 0, 0, timezone.JST),
+				Opened:   []github.PullRequest{{}, {}},
+				Merged:   []github.PullRequest{{}},
+				Reviewed: []github.PullRequest{{}},
+			},
+		},
+	}
+	return report, previous
+}
+
+func TestMarkdownCode(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"type/bug", "`type/bug`"},
+		{"don`t merge", "``don`t merge``"},
+		{"a``b", "```a``b```"},
+		{"`wip`", "`` `wip` ``"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := markdownCode(tt.input); got != tt.want {
+				t.Errorf("markdownCode(%q): got %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderMarkdown_Sections(t *testing.T) {
+	report, previous := markdownSectionsReport()
+
+	var buf bytes.Buffer
+	if err := RenderMarkdown(&buf, report, previous); err != nil {
+		t.Fatalf("RenderMarkdown failed: %v", err)
+	}
+	md := buf.String()
+
+	for _, want := range []string{
+		"## Summary\n\n| | Count | vs previous period (2024/12/30 〜 2025/01/19) |\n|---|---:|---:|\n",
+		"| Opened | 1 | -1 |\n",
+		"| Merged | 3 | +2 |\n",
+		"| Closed | 0 | ±0 |\n",
+		"| Reviewed | 0 | -1 |\n",
+		"Merged changes: +40 / -7\n",
+		"## Repositories\n\n| Repository | Merged |\n|---|---:|\n| test-org/api | 2 |\n| test-org/web | 1 |\n",
+		"## Weekly\n\n| Week | Opened | Draft | Merged | Closed | Reviewed |\n",
+		"## Monthly\n\n| Month | Opened | Draft | Merged | Closed | Reviewed |\n",
+		"| Jan 2025 | 1 | 0 | 2 | 0 | 0 |\n",
+		"| Feb 2025 | 0 | 0 | 1 | 0 | 0 |\n",
+		"## 2025-02-03 (Mon)",
+	} {
+		if !strings.Contains(md, want) {
+			t.Errorf("Markdown should contain %q", want)
+		}
+	}
+
+	// Sections follow the default order
+	order := []string{"## Summary", "## Repositories", "## Weekly", "## Monthly", "## 2025-02-03"}
+	for i := 1; i < len(order); i++ {
+		if strings.Index(md, order[i-1]) > strings.Index(md, order[i]) {
+			t.Errorf("%q should come before %q", order[i-1], order[i])
+		}
+	}
+}
+
+func TestRenderMarkdown_SelectedSections(t *testing.T) {
+	report, previous := markdownSectionsReport()
+
+	var buf bytes.Buffer
+	if err := RenderMarkdown(&buf, report, previous, WithMarkdownSections(SectionRepos, SectionSummary)); err != nil {
+		t.Fatalf("RenderMarkdown failed: %v", err)
+	}
+	md := buf.String()
+
+	for _, unwanted := range []string{"## Weekly", "## Monthly", "## 2025-", "### Merged"} {
+		if strings.Contains(md, unwanted) {
+			t.Errorf("Markdown should not contain %q", unwanted)
+		}
+	}
+	repos, summary := strings.Index(md, "## Repositories"), strings.Index(md, "## Summary")
+	if repos < 0 || summary < 0 || repos > summary {
+		t.Errorf("sections should be in the given order: repos at %d, summary at %d", repos, summary)
+	}
+}
+
+func TestRenderMarkdown_NoPrevious(t *testing.T) {
+	report, _ := markdownSectionsReport()
+
+	var buf bytes.Buffer
+	if err := RenderMarkdown(&buf, report, nil, WithMarkdownSections(SectionSummary)); err != nil {
+		t.Fatalf("RenderMarkdown failed: %v", err)
+	}
+	if !strings.Contains(buf.String(), "| | Count |\n|---|---:|\n| Opened | 1 |\n") {
+		t.Errorf("summary without a comparison period should have no delta column:\n%s", buf.String())
+	}
+}
@@ -0,0 +1,91 @@
+package render
+
+import (
+	"github.com/taikicoco/shiraberu/internal/holiday"
+	"github.com/taikicoco/shiraberu/internal/pr"
+)
+
+// Option はレンダリングの設定オプション
+type Option func(*options)
+
+type options struct {
+	history          []*pr.Report
+	baselineLabel    string
+	businessCalendar *holiday.Calendar
+	delimiter        rune
+	markdownSections []MarkdownSection
+	template         *Template
+	chartJS          ChartJSMode
+	chartMode        ChartMode
+	chartPrefix      string
+}
+
+// WithHistory は推移の比較に使用する直近の過去期間のレポートを新しい順に設定する。
+// nil の要素は取得できなかった期間として無視される
+func WithHistory(reports ...*pr.Report) Option {
+	return func(o *options) {
+		o.history = reports
+	}
+}
+
+// WithBaselineLabel は比較対象の期間の表示名（例: "same period last year"）を設定する
+func WithBaselineLabel(label string) Option {
+	return func(o *options) {
+		o.baselineLabel = label
+	}
+}
+
+// WithBusinessCalendar は稼働日の判定に使用する休日カレンダーを設定する。
+// 設定しない場合は土日以外を稼働日とする
+func WithBusinessCalendar(cal *holiday.Calendar) Option {
+	return func(o *options) {
+		o.businessCalendar = cal
+	}
+}
+
+// WithDelimiter は CSV 出力の区切り文字を設定する（デフォルトはカンマ、TSV の場合は '\t'）
+func WithDelimiter(delimiter rune) Option {
+	return func(o *options) {
+		o.delimiter = delimiter
+	}
+}
+
+// WithMarkdownSections は Markdown 出力に含めるセクションを指定した順に設定する。
+// 設定しない場合は MarkdownSections の全てのセクションを出力する
+func WithMarkdownSections(sections ...MarkdownSection) Option {
+	return func(o *options) {
+		o.markdownSections = sections
+	}
+}
+
+// WithTemplate は HTML と Markdown の出力に使用するユーザー指定のテンプレートを設定する。
+// テンプレートに含まれない形式は組み込みの出力を使用する
+func WithTemplate(t *Template) Option {
+	return func(o *options) {
+		o.template = t
+	}
+}
+
+// WithChartJS は HTML レポートへの Chart.js の組み込み方を設定する（デフォルトは Chart.js のビルドが埋め込まれていれば ChartJSInline、なければ ChartJSCDN）
+func WithChartJS(mode ChartJSMode) Option {
+	return func(o *options) {
+		o.chartJS = mode
+	}
+}
+
+// WithMarkdownCharts は Markdown 出力に SVG グラフを含める。
+// ChartFiles の場合は prefix を付けたファイル名（Chart.FileName）を参照するため、RenderCharts のグラフを同じ名前で保存する
+func WithMarkdownCharts(mode ChartMode, prefix string) Option {
+	return func(o *options) {
+		o.chartMode = mode
+		o.chartPrefix = prefix
+	}
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{baselineLabel: "previous period", delimiter: ',', markdownSections: MarkdownSections, chartJS: defaultChartJSMode(), chartMode: ChartNone}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
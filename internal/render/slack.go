@@ -0,0 +1,242 @@
+package render
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/taikicoco/shiraberu/internal/pr"
+)
+
+const (
+	slackTopRepos      = 5
+	slackMaxMergedPRs  = 30
+	slackMaxSectionLen = 3000 // section ブロックの text の上限
+)
+
+// slackEscaper は mrkdwn で制御文字として扱われる &, <, > をエスケープする
+var slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// slackLinkTextEscaper はリンク <url|text> のテキストが途中で切れないよう | を全角の ｜ に置き換える（mrkdwn には | のエスケープがない）
+var slackLinkTextEscaper = strings.NewReplacer("|", "｜")
+
+// slackMessage は Block Kit と mrkdwn の出力に共通する内容
+type slackMessage struct {
+	title    string
+	context  string
+	fields   []slackField
+	repos    []string // "• repo: n" 形式の行
+	merged   []string // "• <url|title> · repo" 形式の行
+	more     int      // 表示しきれなかったマージ済みPRの数
+	warnings []string
+	empty    bool
+}
+
+type slackField struct {
+	label string
+	value string
+}
+
+func newSlackMessage(report, previousReport *pr.Report, o *options) slackMessage {
+	m := slackMessage{
+		title: "PR Log (" + formatPeriod(report.StartDate, report.EndDate) + ")",
+		empty: len(report.Days) == 0,
+	}
+
+	orgs := make([]string, len(report.Orgs))
+	for i, org := range report.Orgs {
+		orgs[i] = "@" + org
+	}
+	context := []string{"Org: " + strings.Join(orgs, ", ")}
+	if report.Username != "" {
+		context = append(context, "User: @"+report.Username)
+	}
+	if !report.LabelFilter.IsEmpty() {
+		context = append(context, "Labels: "+report.LabelFilter.String())
+	}
+	m.context = slackEscaper.Replace(strings.Join(context, " · "))
+
+	for _, w := range report.Warnings {
+		m.warnings = append(m.warnings, slackEscaper.Replace(w))
+	}
+	if m.empty {
+		return m
+	}
+
+	summary := calcSummary(report)
+	diff := calcSummaryDiff(summary, previousReport)
+	diff.Label = o.baselineLabel
+	counts := []struct {
+		label string
+		count int
+		diff  int
+	}{
+		{"Opened", summary.OpenedCount, diff.OpenedDiff},
+		{"Draft", summary.DraftCount, diff.DraftDiff},
+		{"Merged", summary.MergedCount, diff.MergedDiff},
+		{"Closed", summary.ClosedCount, diff.ClosedDiff},
+		{"Reviewed", summary.ReviewedCount, diff.ReviewedDiff},
+	}
+	for _, c := range counts {
+		value := fmt.Sprintf("%d", c.count)
+		if diff.HasPrevious {
+			value += fmt.Sprintf(" (%s vs %s)", formatDiff(c.diff), slackEscaper.Replace(diff.Label))
+		}
+		m.fields = append(m.fields, slackField{c.label, value})
+	}
+	m.fields = append(m.fields, slackField{"Merged changes", fmt.Sprintf("+%d / -%d", summary.Additions, summary.Deletions)})
+
+	repoStats := calcRepoStats(report)
+	for i, s := range repoStats {
+		if i == slackTopRepos {
+			break
+		}
+		m.repos = append(m.repos, fmt.Sprintf("• %s: %d", slackEscaper.Replace(s.Repository), s.Count))
+	}
+
+	for _, day := range report.Days {
+		for _, p := range day.Merged {
+			if len(m.merged) == slackMaxMergedPRs {
+				m.more++
+				continue
+			}
+			title := slackEscaper.Replace(p.Title)
+			if p.URL != "" {
+				title = fmt.Sprintf("<%s|%s>", p.URL, slackLinkTextEscaper.Replace(title))
+			}
+			m.merged = append(m.merged, fmt.Sprintf("• %s · %s", title, slackEscaper.Replace(p.Repository)))
+		}
+	}
+	return m
+}
+
+// fallbackText は通知やブロックを表示できないクライアント向けの1行の要約を返す
+func (m slackMessage) fallbackText() string {
+	if m.empty {
+		return m.title + ": no pull requests found"
+	}
+	parts := make([]string, 0, len(m.fields))
+	for _, f := range m.fields[:5] {
+		parts = append(parts, strings.SplitN(f.value, " ", 2)[0]+" "+strings.ToLower(f.label))
+	}
+	return m.title + ": " + strings.Join(parts, ", ")
+}
+
+// slackText は Block Kit のテキストオブジェクト
+type slackText struct {
+	Type  string `json:"type"` // "plain_text" または "mrkdwn"
+	Text  string `json:"text"`
+	Emoji bool   `json:"emoji,omitempty"`
+}
+
+// slackBlock は Block Kit のブロック（header, context, section, divider）
+type slackBlock struct {
+	Type     string      `json:"type"`
+	Text     *slackText  `json:"text,omitempty"`
+	Fields   []slackText `json:"fields,omitempty"`
+	Elements []slackText `json:"elements,omitempty"`
+}
+
+// slackPayload は Incoming Webhook にそのまま POST できるメッセージ
+type slackPayload struct {
+	Text   string       `json:"text"`
+	Blocks []slackBlock `json:"blocks"`
+}
+
+func mrkdwn(text string) *slackText {
+	return &slackText{Type: "mrkdwn", Text: text}
+}
+
+// RenderSlack はレポートの要約を Slack の Block Kit 形式の JSON（Incoming Webhook のペイロード）で出力する
+func RenderSlack(w io.Writer, report *pr.Report, previousReport *pr.Report, opts ...Option) error {
+	m := newSlackMessage(report, previousReport, newOptions(opts))
+
+	blocks := []slackBlock{
+		{Type: "header", Text: &slackText{Type: "plain_text", Text: m.title, Emoji: true}},
+		{Type: "context", Elements: []slackText{*mrkdwn(m.context)}},
+	}
+	for _, warning := range m.warnings {
+		blocks = append(blocks, slackBlock{Type: "context", Elements: []slackText{*mrkdwn(":warning: " + warning)}})
+	}
+
+	if m.empty {
+		blocks = append(blocks, slackBlock{Type: "section", Text: mrkdwn("No pull requests found.")})
+	} else {
+		fields := make([]slackText, len(m.fields))
+		for i, f := range m.fields {
+			fields[i] = *mrkdwn("*" + f.label + "*\n" + f.value)
+		}
+		blocks = append(blocks, slackBlock{Type: "section", Fields: fields})
+
+		if len(m.repos) > 0 {
+			blocks = append(blocks,
+				slackBlock{Type: "divider"},
+				slackBlock{Type: "section", Text: mrkdwn("*Top repositories*\n" + strings.Join(m.repos, "\n"))},
+			)
+		}
+		if len(m.merged) > 0 {
+			blocks = append(blocks, slackBlock{Type: "divider"})
+			for _, text := range splitSlackSection("*Merged PRs*", m.merged) {
+				blocks = append(blocks, slackBlock{Type: "section", Text: mrkdwn(text)})
+			}
+			if m.more > 0 {
+				blocks = append(blocks, slackBlock{Type: "context", Elements: []slackText{*mrkdwn(fmt.Sprintf("…and %d more", m.more))}})
+			}
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	return enc.Encode(slackPayload{Text: m.fallbackText(), Blocks: blocks})
+}
+
+// splitSlackSection は section ブロックの文字数の上限に収まるよう行をまとめる
+func splitSlackSection(heading string, lines []string) []string {
+	var texts []string
+	current := heading
+	for _, line := range lines {
+		if len(current)+1+len(line) > slackMaxSectionLen {
+			texts = append(texts, current)
+			current = line
+			continue
+		}
+		current += "\n" + line
+	}
+	return append(texts, current)
+}
+
+// RenderSlackMrkdwn はレポートの要約を Slack の mrkdwn 形式のテキストで出力する（メッセージ欄への貼り付け用）
+func RenderSlackMrkdwn(w io.Writer, report *pr.Report, previousReport *pr.Report, opts ...Option) error {
+	m := newSlackMessage(report, previousReport, newOptions(opts))
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "*%s*\n%s\n", slackEscaper.Replace(m.title), m.context)
+	for _, warning := range m.warnings {
+		fmt.Fprintf(&b, ":warning: %s\n", warning)
+	}
+	b.WriteString("\n")
+
+	if m.empty {
+		b.WriteString("No pull requests found.\n")
+		_, err := io.WriteString(w, b.String())
+		return err
+	}
+
+	for _, f := range m.fields {
+		fmt.Fprintf(&b, "*%s:* %s\n", f.label, f.value)
+	}
+	if len(m.repos) > 0 {
+		fmt.Fprintf(&b, "\n*Top repositories*\n%s\n", strings.Join(m.repos, "\n"))
+	}
+	if len(m.merged) > 0 {
+		fmt.Fprintf(&b, "\n*Merged PRs*\n%s\n", strings.Join(m.merged, "\n"))
+		if m.more > 0 {
+			fmt.Fprintf(&b, "_…and %d more_\n", m.more)
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,170 @@
+package render
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/taikicoco/shiraberu/internal/github"
+	"github.com/taikicoco/shiraberu/internal/pr"
+	"github.com/taikicoco/shiraberu/internal/timezone"
+)
+
+func TestRenderSlack(t *testing.T) {
+	report, previous := markdownSectionsReport()
+	report.Username = "alice"
+	report.Days[0].Merged[0].URL = "https://github.com/test-org/api/pull/2"
+	report.Days[0].Merged[0].Title = "Feature | part 1"
+	report.Days[1].Merged[0].Title = "Fix <b> & more"
+
+	var buf bytes.Buffer
+	if err := RenderSlack(&buf, report, previous); err != nil {
+		t.Fatalf("RenderSlack failed: %v", err)
+	}
+
+	var payload slackPayload
+	if err := json.Unmarshal(buf.Bytes(), &payload); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, buf.String())
+	}
+
+	if want := "PR Log (2025/01/20 〜 2025/02/09): 1 opened, 0 draft, 3 merged, 0 closed, 0 reviewed"; payload.Text != want {
+		t.Errorf("text: got %q, want %q", payload.Text, want)
+	}
+
+	var types []string
+	for _, b := range payload.Blocks {
+		types = append(types, b.Type)
+	}
+	if got := strings.Join(types, ","); got != "header,context,section,divider,section,divider,section" {
+		t.Fatalf("unexpected blocks: %s", got)
+	}
+
+	if got := payload.Blocks[0].Text.Text; got != "PR Log (2025/01/20 〜 2025/02/09)" {
+		t.Errorf("header: got %q", got)
+	}
+	if got := payload.Blocks[1].Elements[0].Text; got != "Org: @test-org · User: @alice" {
+		t.Errorf("context: got %q", got)
+	}
+	if got := payload.Blocks[2].Fields[2].Text; got != "*Merged*\n3 (+2 vs previous period)" {
+		t.Errorf("merged field: got %q", got)
+	}
+	if got := payload.Blocks[4].Text.Text; got != "*Top repositories*\n• test-org/api: 2\n• test-org/web: 1" {
+		t.Errorf("repositories: got %q", got)
+	}
+	wantMerged := "*Merged PRs*\n" +
+		"• <https://github.com/test-org/api/pull/2|Feature ｜ part 1> · test-org/api\n" +
+		"• Fix &lt;b&gt; &amp; more · test-org/api\n" +
+		"• Docs · test-org/web"
+	if got := payload.Blocks[6].Text.Text; got != wantMerged {
+		t.Errorf("merged PRs:\ngot  %q\nwant %q", got, wantMerged)
+	}
+}
+
+func TestRenderSlack_ManyPRs(t *testing.T) {
+	report := &pr.Report{
+		Orgs:      []string{"test-org"},
+		StartDate: time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST),
+		EndDate:   time.Date(2025, 1, 31, 0, 0, 0, 0, timezone.JST),
+	}
+	day := pr.DailyPRs{Date: report.StartDate}
+	for i := range slackMaxMergedPRs + 5 {
+		day.Merged = append(day.Merged, github.PullRequest{
+			Title:      fmt.Sprintf("%03d %s", i, strings.Repeat("long title ", 20)),
+			URL:        fmt.Sprintf("https://github.com/test-org/api/pull/%d", i),
+			Repository: "test-org/api",
+		})
+	}
+	report.Days = []pr.DailyPRs{day}
+
+	var buf bytes.Buffer
+	if err := RenderSlack(&buf, report, nil); err != nil {
+		t.Fatalf("RenderSlack failed: %v", err)
+	}
+	var payload slackPayload
+	if err := json.Unmarshal(buf.Bytes(), &payload); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+
+	merged := 0
+	for _, b := range payload.Blocks {
+		if b.Type == "section" && b.Text != nil {
+			if len(b.Text.Text) > slackMaxSectionLen {
+				t.Errorf("section text exceeds %d characters: %d", slackMaxSectionLen, len(b.Text.Text))
+			}
+			merged += strings.Count(b.Text.Text, "• <https://")
+		}
+		if b.Type == "section" && b.Fields != nil && strings.Contains(b.Fields[0].Text, " vs ") {
+			t.Error("unexpected delta without a previous period")
+		}
+	}
+	if merged != slackMaxMergedPRs {
+		t.Errorf("expected %d merged PRs, got %d", slackMaxMergedPRs, merged)
+	}
+	last := payload.Blocks[len(payload.Blocks)-1]
+	if last.Type != "context" || last.Elements[0].Text != "…and 5 more" {
+		t.Errorf("unexpected last block: %+v", last)
+	}
+}
+
+func TestRenderSlack_Empty(t *testing.T) {
+	report := &pr.Report{
+		Orgs:      []string{"test-org"},
+		StartDate: time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST),
+		EndDate:   time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST),
+		Warnings:  []string{"search truncated"},
+	}
+
+	var buf bytes.Buffer
+	if err := RenderSlack(&buf, report, nil); err != nil {
+		t.Fatalf("RenderSlack failed: %v", err)
+	}
+	var payload slackPayload
+	if err := json.Unmarshal(buf.Bytes(), &payload); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if payload.Text != "PR Log (2025/01/01): no pull requests found" {
+		t.Errorf("text: got %q", payload.Text)
+	}
+	if got := payload.Blocks[2].Elements[0].Text; got != ":warning: search truncated" {
+		t.Errorf("warning: got %q", got)
+	}
+	if got := payload.Blocks[3].Text.Text; got != "No pull requests found." {
+		t.Errorf("got %q", got)
+	}
+}
+
+func TestRenderSlackMrkdwn(t *testing.T) {
+	report, previous := markdownSectionsReport()
+	report.Days[0].Merged[0].URL = "https://github.com/test-org/api/pull/2"
+
+	var buf bytes.Buffer
+	if err := RenderSlackMrkdwn(&buf, report, previous, WithBaselineLabel("last month")); err != nil {
+		t.Fatalf("RenderSlackMrkdwn failed: %v", err)
+	}
+
+	want := `*PR Log (2025/01/20 〜 2025/02/09)*
+Org: @test-org
+
+*Opened:* 1 (-1 vs last month)
+*Draft:* 0 (±0 vs last month)
+*Merged:* 3 (+2 vs last month)
+*Closed:* 0 (±0 vs last month)
+*Reviewed:* 0 (-1 vs last month)
+*Merged changes:* +40 / -7
+
+*Top repositories*
+• test-org/api: 2
+• test-org/web: 1
+
+*Merged PRs*
+• <https://github.com/test-org/api/pull/2|Feature> · test-org/api
+• Fix · test-org/api
+• Docs · test-org/web
+`
+	if got := buf.String(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -3,8 +3,11 @@ package render
 import (
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
+	"github.com/taikicoco/shiraberu/internal/github"
+	"github.com/taikicoco/shiraberu/internal/holiday"
 	"github.com/taikicoco/shiraberu/internal/pr"
 )
 
@@ -14,7 +17,12 @@ func calcSummary(report *pr.Report) Summary {
 		s.OpenedCount += len(day.Opened)
 		s.DraftCount += len(day.Draft)
 		s.MergedCount += len(day.Merged)
+		s.ClosedCount += len(day.Closed)
 		s.ReviewedCount += len(day.Reviewed)
+		approved, changesRequested, commented := countReviewVerdicts(day.Reviewed)
+		s.ApprovedCount += approved
+		s.ChangesRequestedCount += changesRequested
+		s.CommentedCount += commented
 		// Additions/Deletions are only counted for merged PRs
 		for _, p := range day.Merged {
 			s.Additions += p.Additions
@@ -58,14 +66,20 @@ func calcDailyStats(report *pr.Report) []DailyStat {
 			additions += p.Additions
 			deletions += p.Deletions
 		}
+		for _, p := range day.Closed {
+			additions += p.Additions
+			deletions += p.Deletions
+		}
 
 		stat.OpenedCount = len(day.Opened)
 		stat.DraftCount = len(day.Draft)
 		stat.MergedCount = len(day.Merged)
+		stat.ClosedCount = len(day.Closed)
 		stat.ReviewedCount = len(day.Reviewed)
 		stat.Additions = additions
 		stat.Deletions = deletions
-		stat.TotalPRs = len(day.Opened) + len(day.Draft) + len(day.Merged) + len(day.Reviewed)
+		stat.TotalPRs = len(day.Opened) + len(day.Draft) + len(day.Merged) + len(day.Closed) + len(day.Reviewed)
+		stat.ApprovedCount, stat.ChangesRequestedCount, stat.CommentedCount = countReviewVerdicts(day.Reviewed)
 	}
 
 	// スライスに変換
@@ -82,25 +96,75 @@ func calcDailyStats(report *pr.Report) []DailyStat {
 	return stats
 }
 
+// markBusinessDays は日別統計に稼働日と休日の情報を設定する
+func markBusinessDays(stats []DailyStat, cal *holiday.Calendar) {
+	for i := range stats {
+		date, err := time.Parse("2006-01-02", stats[i].Date)
+		if err != nil {
+			continue
+		}
+		stats[i].IsBusinessDay = cal.IsBusinessDay(date)
+		if name, ok := cal.HolidayName(date); ok {
+			if name == "" {
+				name = "Holiday"
+			}
+			stats[i].HolidayName = name
+		}
+	}
+}
+
+// calcBusinessDayStat は markBusinessDays 済みの日別統計から稼働日ベースの統計を計算する
+func calcBusinessDayStat(stats []DailyStat) BusinessDayStat {
+	var b BusinessDayStat
+	var opened, merged, reviewed int
+	for _, s := range stats {
+		if s.HolidayName != "" {
+			b.Holidays = append(b.Holidays, HolidayStat{Date: s.Date, Name: s.HolidayName})
+		}
+		if !s.IsBusinessDay {
+			continue
+		}
+		b.BusinessDays++
+		if s.TotalPRs == 0 {
+			b.QuietDays++
+		}
+		opened += s.OpenedCount
+		merged += s.MergedCount
+		reviewed += s.ReviewedCount
+	}
+	if b.BusinessDays > 0 {
+		b.OpenedPerDay = float64(opened) / float64(b.BusinessDays)
+		b.MergedPerDay = float64(merged) / float64(b.BusinessDays)
+		b.ReviewedPerDay = float64(reviewed) / float64(b.BusinessDays)
+	}
+	return b
+}
+
+// calcNonBusinessDays はグラフで強調表示する稼働日でない日付を返す
+func calcNonBusinessDays(stats []DailyStat) map[string]string {
+	days := make(map[string]string)
+	for _, s := range stats {
+		if !s.IsBusinessDay {
+			days[s.Date] = s.HolidayName
+		}
+	}
+	return days
+}
+
 func calcWeeklyStats(report *pr.Report) []WeeklyStat {
 	weekMap := make(map[string]*WeeklyStat)
 
-	// 期間内の全週を0で初期化
+	// 期間内の全週を0で初期化（週の始まりは report.Calendar に従う）
 	for d := report.StartDate; !d.After(report.EndDate); d = d.AddDate(0, 0, 1) {
-		year, week := d.ISOWeek()
-		weekKey := fmt.Sprintf("%d-W%02d", year, week)
+		weekStart := report.Calendar.StartOfWeek(d)
+		weekKey := weekStart.Format("2006-01-02")
 
 		if _, ok := weekMap[weekKey]; !ok {
-			// Calculate week start date (Monday) for label
-			weekStart := d.AddDate(0, 0, -int(d.Weekday())+1)
-			if d.Weekday() == 0 { // Sunday
-				weekStart = d.AddDate(0, 0, -6)
-			}
-			weekEnd := weekStart.AddDate(0, 0, 6) // Sunday
+			weekEnd := weekStart.AddDate(0, 0, 6)
 			weekLabel := fmt.Sprintf("%d/%d 〜 %d/%d", weekStart.Month(), weekStart.Day(), weekEnd.Month(), weekEnd.Day())
 			weekMap[weekKey] = &WeeklyStat{
 				Week:      weekLabel,
-				StartDate: weekStart.Format("2006-01-02"),
+				StartDate: weekKey,
 				EndDate:   weekEnd.Format("2006-01-02"),
 			}
 		}
@@ -108,13 +172,13 @@ func calcWeeklyStats(report *pr.Report) []WeeklyStat {
 
 	// PRがある日のデータを埋める
 	for _, day := range report.Days {
-		year, week := day.Date.ISOWeek()
-		weekKey := fmt.Sprintf("%d-W%02d", year, week)
+		weekKey := report.Calendar.StartOfWeek(day.Date).Format("2006-01-02")
 
 		if stat, ok := weekMap[weekKey]; ok {
 			stat.OpenedCount += len(day.Opened)
 			stat.DraftCount += len(day.Draft)
 			stat.MergedCount += len(day.Merged)
+			stat.ClosedCount += len(day.Closed)
 			stat.ReviewedCount += len(day.Reviewed)
 		}
 	}
@@ -165,6 +229,7 @@ func calcMonthlyStats(report *pr.Report) []MonthlyStat {
 			stat.OpenedCount += len(day.Opened)
 			stat.DraftCount += len(day.Draft)
 			stat.MergedCount += len(day.Merged)
+			stat.ClosedCount += len(day.Closed)
 			stat.ReviewedCount += len(day.Reviewed)
 		}
 	}
@@ -184,6 +249,49 @@ func calcMonthlyStats(report *pr.Report) []MonthlyStat {
 	return stats
 }
 
+func calcQuarterlyStats(report *pr.Report) []QuarterlyStat {
+	quarterMap := make(map[string]*QuarterlyStat)
+
+	// 期間内の全四半期を0で初期化
+	if !report.StartDate.After(report.EndDate) {
+		for d := report.Calendar.StartOfQuarter(report.StartDate); !d.After(report.EndDate); d = d.AddDate(0, 3, 0) {
+			quarterKey := d.Format("2006-01")
+			quarterMap[quarterKey] = &QuarterlyStat{
+				Quarter:   report.Calendar.QuarterLabel(d),
+				StartDate: d.Format("2006-01-02"),
+				EndDate:   d.AddDate(0, 3, -1).Format("2006-01-02"),
+			}
+		}
+	}
+
+	// PRがある日のデータを埋める
+	for _, day := range report.Days {
+		quarterKey := report.Calendar.StartOfQuarter(day.Date).Format("2006-01")
+
+		if stat, ok := quarterMap[quarterKey]; ok {
+			stat.OpenedCount += len(day.Opened)
+			stat.DraftCount += len(day.Draft)
+			stat.MergedCount += len(day.Merged)
+			stat.ClosedCount += len(day.Closed)
+			stat.ReviewedCount += len(day.Reviewed)
+		}
+	}
+
+	// スライスに変換してソート
+	stats := make([]QuarterlyStat, 0, len(quarterMap))
+	keys := make([]string, 0, len(quarterMap))
+	for k := range quarterMap {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		stats = append(stats, *quarterMap[k])
+	}
+
+	return stats
+}
+
 func calcRepoStats(report *pr.Report) []RepoStat {
 	repoCount := make(map[string]int)
 	for _, day := range report.Days {
@@ -208,6 +316,83 @@ func calcRepoStats(report *pr.Report) []RepoStat {
 	return stats
 }
 
+// calcOrgStats はOrganization別にPRを集計する。単一Organizationの場合は nil を返す。
+// GitHub の Organization 名は大文字小文字を区別しないため、指定された表記の行に集計する
+func calcOrgStats(report *pr.Report) []OrgStat {
+	if len(report.Orgs) < 2 {
+		return nil
+	}
+
+	stats := make([]OrgStat, 0, len(report.Orgs))
+	index := make(map[string]int, len(report.Orgs))
+	for _, org := range report.Orgs {
+		index[strings.ToLower(org)] = len(stats)
+		stats = append(stats, OrgStat{Org: org})
+	}
+	statFor := func(p github.PullRequest) *OrgStat {
+		org := p.Owner()
+		i, ok := index[strings.ToLower(org)]
+		if !ok {
+			i = len(stats)
+			index[strings.ToLower(org)] = i
+			stats = append(stats, OrgStat{Org: org})
+		}
+		return &stats[i]
+	}
+
+	for _, day := range report.Days {
+		for _, p := range day.Opened {
+			statFor(p).OpenedCount++
+		}
+		for _, p := range day.Draft {
+			statFor(p).DraftCount++
+		}
+		for _, p := range day.Merged {
+			statFor(p).MergedCount++
+		}
+		for _, p := range day.Closed {
+			statFor(p).ClosedCount++
+		}
+		for _, p := range day.Reviewed {
+			statFor(p).ReviewedCount++
+		}
+	}
+
+	return stats
+}
+
+// calcLabelStats は全カテゴリのPRをラベルごとに集計する
+func calcLabelStats(report *pr.Report) []LabelStat {
+	labelCount := make(map[string]int)
+	for _, day := range report.Days {
+		for _, prs := range [][]github.PullRequest{day.Opened, day.Draft, day.Merged, day.Closed, day.Reviewed} {
+			for _, p := range prs {
+				for _, label := range p.Labels {
+					labelCount[label]++
+				}
+			}
+		}
+	}
+
+	stats := make([]LabelStat, 0, len(labelCount))
+	for label, count := range labelCount {
+		stats = append(stats, LabelStat{
+			Label: label,
+			Count: count,
+		})
+	}
+
+	// 件数の多い順、同数の場合はラベル名順にソート
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Count != stats[j].Count {
+			return stats[i].Count > stats[j].Count
+		}
+		return stats[i].Label < stats[j].Label
+	})
+
+	return stats
+}
+
 func calcSummaryDiff(current Summary, previousReport *pr.Report) SummaryDiff {
 	if previousReport == nil {
 		return SummaryDiff{HasPrevious: false}
@@ -215,10 +400,70 @@ func calcSummaryDiff(current Summary, previousReport *pr.Report) SummaryDiff {
 
 	prev := calcSummary(previousReport)
 	return SummaryDiff{
+		Period:       formatPeriod(previousReport.StartDate, previousReport.EndDate),
 		OpenedDiff:   current.OpenedCount - prev.OpenedCount,
 		DraftDiff:    current.DraftCount - prev.DraftCount,
 		MergedDiff:   current.MergedCount - prev.MergedCount,
+		ClosedDiff:   current.ClosedCount - prev.ClosedCount,
 		ReviewedDiff: current.ReviewedCount - prev.ReviewedCount,
 		HasPrevious:  true,
 	}
 }
+
+// calcSummaryTrend は過去期間のレポート（新しい順）と今期の集計から推移を計算する
+func calcSummaryTrend(current Summary, history []*pr.Report) SummaryTrend {
+	var previous []Summary
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i] != nil {
+			previous = append(previous, calcSummary(history[i]))
+		}
+	}
+	if len(previous) == 0 {
+		return SummaryTrend{}
+	}
+
+	stat := func(value func(Summary) int) TrendStat {
+		s := TrendStat{Values: make([]int, 0, len(previous)+1)}
+		sum := 0
+		for i, p := range previous {
+			v := value(p)
+			if i == 0 || v < s.Min {
+				s.Min = v
+			}
+			if i == 0 || v > s.Max {
+				s.Max = v
+			}
+			sum += v
+			s.Values = append(s.Values, v)
+		}
+		s.Average = float64(sum) / float64(len(previous))
+		s.Values = append(s.Values, value(current))
+		return s
+	}
+
+	return SummaryTrend{
+		Periods:  len(previous),
+		Opened:   stat(func(s Summary) int { return s.OpenedCount }),
+		Draft:    stat(func(s Summary) int { return s.DraftCount }),
+		Merged:   stat(func(s Summary) int { return s.MergedCount }),
+		Closed:   stat(func(s Summary) int { return s.ClosedCount }),
+		Reviewed: stat(func(s Summary) int { return s.ReviewedCount }),
+	}
+}
+
+// countReviewVerdicts はPRに含まれるレビューを結果（承認・変更要求・コメント）別に数える
+func countReviewVerdicts(prs []github.PullRequest) (approved, changesRequested, commented int) {
+	for _, p := range prs {
+		for _, r := range p.Reviews {
+			switch r.State {
+			case github.ReviewApproved:
+				approved++
+			case github.ReviewChangesRequested:
+				changesRequested++
+			case github.ReviewCommented:
+				commented++
+			}
+		}
+	}
+	return approved, changesRequested, commented
+}
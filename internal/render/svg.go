@@ -0,0 +1,296 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"io"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/taikicoco/shiraberu/internal/pr"
+)
+
+// Chart は Markdown やメールに埋め込むための SVG グラフ
+type Chart struct {
+	Name  string // ファイル名に使用する識別子 (例: activity)
+	Title string
+	SVG   []byte
+}
+
+// FileName は prefix を付けた SVG のファイル名を返す (例: "report-" → report-activity.svg)
+func (c Chart) FileName(prefix string) string {
+	return prefix + c.Name + ".svg"
+}
+
+// ChartMode は Markdown 出力への SVG グラフの組み込み方
+type ChartMode string
+
+const (
+	ChartNone   ChartMode = "none"   // グラフを含めない
+	ChartFiles  ChartMode = "files"  // レポートと同じ場所に保存した SVG ファイルを参照する
+	ChartInline ChartMode = "inline" // data URI として埋め込む
+)
+
+// ParseChartMode はグラフの組み込み方（none, files, inline）を解釈する。空の場合は none を返す
+func ParseChartMode(s string) (ChartMode, error) {
+	switch mode := ChartMode(strings.ToLower(strings.TrimSpace(s))); mode {
+	case "":
+		return ChartNone, nil
+	case ChartNone, ChartFiles, ChartInline:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("unknown chart mode %q (want %s, %s or %s)", s, ChartNone, ChartFiles, ChartInline)
+	}
+}
+
+// chartSeries は折れ線グラフの系列。色は HTML レポートの Chart.js と揃える
+type chartSeries struct {
+	label  string
+	color  string
+	dashed bool
+	value  func(DailyStat) int
+}
+
+var activitySeries = []chartSeries{
+	{"Opened", "#0f7b6c", false, func(s DailyStat) int { return s.OpenedCount }},
+	{"Draft", "#787774", true, func(s DailyStat) int { return s.DraftCount }},
+	{"Merged", "#6940a5", false, func(s DailyStat) int { return s.MergedCount }},
+	{"Closed", "#e03e3e", false, func(s DailyStat) int { return s.ClosedCount }},
+	{"Reviewed", "#0b6e99", true, func(s DailyStat) int { return s.ReviewedCount }},
+}
+
+const (
+	chartWidth      = 720
+	chartFont       = `font-family="-apple-system, BlinkMacSystemFont, 'Segoe UI', sans-serif" font-size="11" fill="#787774"`
+	chartGridColor  = "#e9e9e7"
+	chartQuietColor = "#f7f6f3" // 稼働日でない日の背景
+	barHeight       = 18
+	barGap          = 8
+	maxChartRepos   = 10
+)
+
+// RenderCharts はレポートの SVG グラフ（日別の推移、リポジトリ別のマージ数、種類別の件数）を返す。
+// PR がない場合やマージがない場合、そのグラフは含まれない
+func RenderCharts(report *pr.Report, opts ...Option) []Chart {
+	o := newOptions(opts)
+	dailyStats := calcDailyStats(report)
+	markBusinessDays(dailyStats, o.businessCalendar)
+
+	var charts []Chart
+	add := func(name, title string, render func(io.Writer) error) {
+		var buf bytes.Buffer
+		if err := render(&buf); err == nil {
+			charts = append(charts, Chart{Name: name, Title: title, SVG: buf.Bytes()})
+		}
+	}
+	if len(report.Days) > 0 {
+		add("activity", "Activity", func(w io.Writer) error { return RenderActivitySVG(w, dailyStats) })
+		add("types", "Contributions by type", func(w io.Writer) error { return RenderContributionSVG(w, dailyStats) })
+	}
+	if repoStats := calcRepoStats(report); len(repoStats) > 0 {
+		add("repos", "Merged PRs by repository", func(w io.Writer) error { return RenderRepoSVG(w, repoStats) })
+	}
+	return charts
+}
+
+// RenderActivitySVG は日別の件数を種類ごとの折れ線グラフとして SVG で出力する。
+// 稼働日でない日は背景を塗り分ける
+func RenderActivitySVG(w io.Writer, stats []DailyStat) error {
+	const (
+		height = 260
+		left   = 40
+		right  = 16
+		top    = 36
+		bottom = 28
+	)
+	plotWidth := float64(chartWidth - left - right)
+	plotHeight := float64(height - top - bottom)
+
+	maxValue := 0
+	for _, s := range stats {
+		for _, series := range activitySeries {
+			maxValue = max(maxValue, series.value(s))
+		}
+	}
+	step := niceStep(maxValue, 4)
+	yMax := max(step*4, step*int(math.Ceil(float64(maxValue)/float64(step))))
+
+	// 各日の x 座標（1日だけの場合は中央）
+	x := func(i int) float64 {
+		if len(stats) <= 1 {
+			return left + plotWidth/2
+		}
+		return left + float64(i)*plotWidth/float64(len(stats)-1)
+	}
+	y := func(v int) float64 {
+		return top + plotHeight - float64(v)*plotHeight/float64(yMax)
+	}
+	dayWidth := plotWidth
+	if len(stats) > 1 {
+		dayWidth = plotWidth / float64(len(stats)-1)
+	}
+
+	var b strings.Builder
+	writeSVGHeader(&b, chartWidth, height, "Activity")
+
+	for i, s := range stats {
+		if s.IsBusinessDay || len(stats) <= 1 {
+			continue
+		}
+		x0 := math.Max(left, x(i)-dayWidth/2)
+		x1 := math.Min(left+plotWidth, x(i)+dayWidth/2)
+		fmt.Fprintf(&b, `<rect x="%.1f" y="%d" width="%.1f" height="%.1f" fill="%s"><title>%s</title></rect>`+"\n",
+			x0, top, x1-x0, plotHeight, chartQuietColor, html.EscapeString(strings.TrimSpace(s.Date+" "+s.HolidayName)))
+	}
+
+	fmt.Fprintf(&b, `<g %s text-anchor="end">`+"\n", chartFont)
+	for v := 0; v <= yMax; v += step {
+		fmt.Fprintf(&b, `<line x1="%d" y1="%.1f" x2="%.1f" y2="%.1f" stroke="%s"/>`, left, y(v), left+plotWidth, y(v), chartGridColor)
+		fmt.Fprintf(&b, `<text x="%d" y="%.1f">%d</text>`+"\n", left-6, y(v)+4, v)
+	}
+	b.WriteString("</g>\n")
+
+	// 日付のラベルは最大8個まで間引く
+	fmt.Fprintf(&b, `<g %s text-anchor="middle">`+"\n", chartFont)
+	every := max(1, int(math.Ceil(float64(len(stats))/8)))
+	for i, s := range stats {
+		if i%every != 0 {
+			continue
+		}
+		label := s.Date
+		if d, err := time.Parse("2006-01-02", s.Date); err == nil {
+			label = d.Format("1/2")
+		}
+		fmt.Fprintf(&b, `<text x="%.1f" y="%d">%s</text>`+"\n", x(i), height-10, html.EscapeString(label))
+	}
+	b.WriteString("</g>\n")
+
+	for _, series := range activitySeries {
+		points := make([]string, len(stats))
+		for i, s := range stats {
+			points[i] = fmt.Sprintf("%.1f,%.1f", x(i), y(series.value(s)))
+		}
+		dash := ""
+		if series.dashed {
+			dash = ` stroke-dasharray="5 5"`
+		}
+		fmt.Fprintf(&b, `<polyline points="%s" fill="none" stroke="%s" stroke-width="2"%s><title>%s</title></polyline>`+"\n",
+			strings.Join(points, " "), series.color, dash, series.label)
+		for i, s := range stats {
+			fmt.Fprintf(&b, `<circle cx="%.1f" cy="%.1f" r="2.5" fill="%s"><title>%s %s: %d</title></circle>`+"\n",
+				x(i), y(series.value(s)), series.color, html.EscapeString(s.Date), series.label, series.value(s))
+		}
+	}
+
+	// 凡例
+	fmt.Fprintf(&b, `<g %s>`+"\n", chartFont)
+	for i, series := range activitySeries {
+		lx := left + i*90
+		fmt.Fprintf(&b, `<rect x="%d" y="12" width="12" height="12" rx="2" fill="%s"/><text x="%d" y="22">%s</text>`+"\n",
+			lx, series.color, lx+18, series.label)
+	}
+	b.WriteString("</g>\n</svg>\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// RenderRepoSVG はリポジトリ別のマージ数を横棒グラフとして SVG で出力する（上位10件）
+func RenderRepoSVG(w io.Writer, stats []RepoStat) error {
+	if len(stats) > maxChartRepos {
+		stats = stats[:maxChartRepos]
+	}
+	bars := make([]chartBar, len(stats))
+	for i, s := range stats {
+		bars[i] = chartBar{label: s.Repository, value: s.Count, color: "#0b6e99"}
+	}
+	return writeBarChart(w, "Merged PRs by repository", bars)
+}
+
+// RenderContributionSVG は期間全体の種類別の件数を横棒グラフとして SVG で出力する
+func RenderContributionSVG(w io.Writer, stats []DailyStat) error {
+	bars := make([]chartBar, len(activitySeries))
+	for i, series := range activitySeries {
+		bars[i] = chartBar{label: series.label, color: series.color}
+		for _, s := range stats {
+			bars[i].value += series.value(s)
+		}
+	}
+	return writeBarChart(w, "Contributions by type", bars)
+}
+
+// chartBar は横棒グラフの1本の棒
+type chartBar struct {
+	label string
+	value int
+	color string
+}
+
+// writeBarChart はラベル・棒・件数を1行ずつ並べた横棒グラフを出力する
+func writeBarChart(w io.Writer, title string, bars []chartBar) error {
+	const (
+		top        = 12
+		labelWidth = 220
+		valueWidth = 48
+	)
+	height := top*2 + len(bars)*(barHeight+barGap)
+	plotWidth := float64(chartWidth - labelWidth - valueWidth)
+
+	maxValue := 0
+	for _, bar := range bars {
+		maxValue = max(maxValue, bar.value)
+	}
+
+	var b strings.Builder
+	writeSVGHeader(&b, chartWidth, height, title)
+	fmt.Fprintf(&b, `<g %s>`+"\n", chartFont)
+	for i, bar := range bars {
+		y := top + i*(barHeight+barGap)
+		width := 0.0
+		if maxValue > 0 {
+			width = float64(bar.value) * plotWidth / float64(maxValue)
+		}
+		label := html.EscapeString(truncateLabel(bar.label, 34))
+		fmt.Fprintf(&b, `<text x="%d" y="%d" text-anchor="end">%s</text>`, labelWidth-8, y+13, label)
+		fmt.Fprintf(&b, `<rect x="%d" y="%d" width="%.1f" height="%d" rx="2" fill="%s"><title>%s: %d</title></rect>`,
+			labelWidth, y, width, barHeight, bar.color, html.EscapeString(bar.label), bar.value)
+		fmt.Fprintf(&b, `<text x="%.1f" y="%d">%d</text>`+"\n", float64(labelWidth)+width+6, y+13, bar.value)
+	}
+	b.WriteString("</g>\n</svg>\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeSVGHeader(b *strings.Builder, width, height int, title string) {
+	fmt.Fprintf(b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" role="img" aria-label="%s">`+"\n",
+		width, height, width, height, html.EscapeString(title))
+	fmt.Fprintf(b, "<title>%s</title>\n", html.EscapeString(title))
+	fmt.Fprintf(b, `<rect width="%d" height="%d" fill="#ffffff"/>`+"\n", width, height)
+}
+
+// niceStep は 0〜maxValue を ticks 個程度に区切る目盛りの間隔（1, 2, 5 × 10^n）を返す
+func niceStep(maxValue, ticks int) int {
+	if maxValue <= ticks {
+		return 1
+	}
+	raw := float64(maxValue) / float64(ticks)
+	magnitude := math.Pow(10, math.Floor(math.Log10(raw)))
+	for _, m := range []float64{1, 2, 5, 10} {
+		if step := m * magnitude; step >= raw {
+			return int(step)
+		}
+	}
+	return int(10 * magnitude)
+}
+
+// truncateLabel は n 文字を超えるラベルを省略する
+func truncateLabel(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n-1]) + "…"
+}
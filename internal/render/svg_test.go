@@ -0,0 +1,189 @@
+package render
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+)
+
+// assertValidSVG は SVG が整形式の XML であることを確認する
+func assertValidSVG(t *testing.T, svg []byte) {
+	t.Helper()
+	dec := xml.NewDecoder(bytes.NewReader(svg))
+	for {
+		_, err := dec.Token()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			t.Fatalf("invalid SVG: %v\n%s", err, svg)
+		}
+	}
+}
+
+func TestRenderActivitySVG(t *testing.T) {
+	stats := []DailyStat{
+		{Date: "2025-01-03", OpenedCount: 2, MergedCount: 1, IsBusinessDay: true},
+		{Date: "2025-01-04", ReviewedCount: 7},
+		{Date: "2025-01-05", HolidayName: "Tom & Jerry Day"},
+	}
+
+	var buf bytes.Buffer
+	if err := RenderActivitySVG(&buf, stats); err != nil {
+		t.Fatalf("RenderActivitySVG failed: %v", err)
+	}
+	assertValidSVG(t, buf.Bytes())
+
+	svg := buf.String()
+	if got := strings.Count(svg, "<polyline"); got != len(activitySeries) {
+		t.Errorf("expected %d series, got %d", len(activitySeries), got)
+	}
+	if got := strings.Count(svg, `fill="`+chartQuietColor+`"`); got != 2 {
+		t.Errorf("expected 2 non-business days shaded, got %d", got)
+	}
+	for _, want := range []string{"Tom &amp; Jerry Day", "2025-01-04 Reviewed: 7", ">1/3<", ">8<"} {
+		if !strings.Contains(svg, want) {
+			t.Errorf("expected %q in SVG", want)
+		}
+	}
+}
+
+func TestRenderActivitySVG_SingleDay(t *testing.T) {
+	var buf bytes.Buffer
+	if err := RenderActivitySVG(&buf, []DailyStat{{Date: "2025-01-03", IsBusinessDay: true}}); err != nil {
+		t.Fatalf("RenderActivitySVG failed: %v", err)
+	}
+	assertValidSVG(t, buf.Bytes())
+	if strings.Contains(buf.String(), "NaN") {
+		t.Error("unexpected NaN in SVG")
+	}
+}
+
+func TestRenderRepoSVG(t *testing.T) {
+	stats := []RepoStat{{"org/<api>", 5}, {"org/web", 2}}
+	for i := 0; i < 12; i++ {
+		stats = append(stats, RepoStat{"org/other", 1})
+	}
+
+	var buf bytes.Buffer
+	if err := RenderRepoSVG(&buf, stats); err != nil {
+		t.Fatalf("RenderRepoSVG failed: %v", err)
+	}
+	assertValidSVG(t, buf.Bytes())
+
+	svg := buf.String()
+	if !strings.Contains(svg, "org/&lt;api&gt;: 5") {
+		t.Error("expected escaped repository name")
+	}
+	if got := strings.Count(svg, `fill="#0b6e99"`); got != maxChartRepos {
+		t.Errorf("expected %d bars, got %d", maxChartRepos, got)
+	}
+}
+
+func TestRenderContributionSVG(t *testing.T) {
+	stats := []DailyStat{
+		{OpenedCount: 1, MergedCount: 2},
+		{MergedCount: 1, ClosedCount: 1, ReviewedCount: 4},
+	}
+
+	var buf bytes.Buffer
+	if err := RenderContributionSVG(&buf, stats); err != nil {
+		t.Fatalf("RenderContributionSVG failed: %v", err)
+	}
+	assertValidSVG(t, buf.Bytes())
+
+	svg := buf.String()
+	for _, want := range []string{"Opened: 1", "Draft: 0", "Merged: 3", "Closed: 1", "Reviewed: 4"} {
+		if !strings.Contains(svg, want) {
+			t.Errorf("expected %q in SVG", want)
+		}
+	}
+}
+
+func TestNiceStep(t *testing.T) {
+	tests := []struct {
+		max  int
+		want int
+	}{
+		{0, 1},
+		{4, 1},
+		{7, 2},
+		{18, 5},
+		{35, 10},
+		{120, 50},
+	}
+	for _, tt := range tests {
+		if got := niceStep(tt.max, 4); got != tt.want {
+			t.Errorf("niceStep(%d, 4): got %d, want %d", tt.max, got, tt.want)
+		}
+	}
+}
+
+func TestRenderMarkdown_Charts(t *testing.T) {
+	report, previous := markdownSectionsReport()
+
+	charts := RenderCharts(report)
+	var names []string
+	for _, c := range charts {
+		names = append(names, c.Name)
+		assertValidSVG(t, c.SVG)
+	}
+	if got := strings.Join(names, ","); got != "activity,types,repos" {
+		t.Errorf("unexpected charts: %s", got)
+	}
+
+	tests := []struct {
+		name string
+		opts []Option
+		want []string
+	}{
+		{"none", nil, nil},
+		{"files", []Option{WithMarkdownCharts(ChartFiles, "report-")}, []string{
+			"## Charts\n\n![Activity](report-activity.svg)\n\n![Contributions by type](report-types.svg)\n\n![Merged PRs by repository](report-repos.svg)\n\n",
+		}},
+		{"inline", []Option{WithMarkdownCharts(ChartInline, "")}, []string{
+			"![Activity](data:image/svg+xml;base64," + base64.StdEncoding.EncodeToString(charts[0].SVG) + ")",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := RenderMarkdown(&buf, report, previous, tt.opts...); err != nil {
+				t.Fatalf("RenderMarkdown failed: %v", err)
+			}
+			got := buf.String()
+			if tt.want == nil && strings.Contains(got, "## Charts") {
+				t.Error("unexpected Charts section")
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("expected %q in:\n%s", want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestParseChartMode(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    ChartMode
+		wantErr bool
+	}{
+		{"", ChartNone, false},
+		{"files", ChartFiles, false},
+		{" Inline ", ChartInline, false},
+		{"png", "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseChartMode(tt.input)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseChartMode(%q): got %q, %v", tt.input, got, err)
+		}
+	}
+}
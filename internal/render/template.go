@@ -0,0 +1,215 @@
+package render
+
+import (
+	"encoding/json"
+	"fmt"
+	htmltemplate "html/template"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	texttemplate "text/template"
+	"text/template/parse"
+	"time"
+)
+
+// Template はユーザーが指定したテンプレート。
+// HTML は組み込みのテンプレートに重ねて解釈するため、"styles" などのパーシャルを個別に上書きできる
+type Template struct {
+	html         *htmltemplate.Template // nil の場合は組み込みのテンプレートを使用する
+	htmlName     string
+	markdown     *texttemplate.Template // nil の場合は組み込みの Markdown 出力を使用する
+	markdownName string
+}
+
+// LoadTemplate はテンプレートのファイルまたはディレクトリを読み込む。
+// *.html は HTMLData、*.md は MarkdownData に対して実行される（.tmpl / .gotmpl を末尾に付けてもよい）。
+// report.html / report.md があればそれを、なければ {{define}} 以外の内容を持つ唯一のファイルを出力に使用する。
+// HTML で {{define}} だけのファイルは組み込みの report.html のパーシャルを上書きする
+func LoadTemplate(path string) (*Template, error) {
+	files, err := templateFiles(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var htmlFiles, markdownFiles []string
+	for _, f := range files {
+		switch templateKind(f) {
+		case ".html":
+			htmlFiles = append(htmlFiles, f)
+		case ".md":
+			markdownFiles = append(markdownFiles, f)
+		default:
+			return nil, fmt.Errorf("%s: unsupported template file (want *.html or *.md)", f)
+		}
+	}
+
+	t := &Template{}
+	if len(htmlFiles) > 0 {
+		base, err := parseHTMLTemplate()
+		if err != nil {
+			return nil, err
+		}
+		if t.html, err = base.ParseFiles(htmlFiles...); err != nil {
+			return nil, err
+		}
+		if t.htmlName, err = entryTemplate(htmlFiles, "report.html", func(name string) *parse.Tree {
+			return t.html.Lookup(name).Tree
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if len(markdownFiles) > 0 {
+		var err error
+		if t.markdown, err = texttemplate.New("").Funcs(textFuncs()).ParseFiles(markdownFiles...); err != nil {
+			return nil, err
+		}
+		if t.markdownName, err = entryTemplate(markdownFiles, "report.md", func(name string) *parse.Tree {
+			return t.markdown.Lookup(name).Tree
+		}); err != nil {
+			return nil, err
+		}
+		if t.markdownName == "" {
+			return nil, fmt.Errorf("%s: no Markdown template to execute (only {{define}} blocks)", path)
+		}
+	}
+	return t, nil
+}
+
+// templateFiles は path がディレクトリの場合はその直下のファイルを名前順に返す
+func templateFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, e := range entries {
+		if e.IsDir() || strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		files = append(files, filepath.Join(path, e.Name()))
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("%s: no template files", path)
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// templateKind はテンプレートの種類を表す拡張子（.html または .md）を返す
+func templateKind(path string) string {
+	name := strings.TrimSuffix(strings.TrimSuffix(filepath.Base(path), ".tmpl"), ".gotmpl")
+	return strings.ToLower(filepath.Ext(name))
+}
+
+// entryTemplate は出力に使用するテンプレート名を決める。
+// defaultName のファイルがあればそれを、なければ {{define}} 以外の内容を持つ唯一のファイルを使用する。
+// そのようなファイルがない場合は defaultName を返す（組み込みのテンプレートを使用する）
+func entryTemplate(files []string, defaultName string, tree func(name string) *parse.Tree) (string, error) {
+	var entries []string
+	for _, f := range files {
+		name := filepath.Base(f)
+		if name == defaultName {
+			return name, nil
+		}
+		if t := tree(name); t != nil && !parse.IsEmptyTree(t.Root) {
+			entries = append(entries, name)
+		}
+	}
+
+	switch len(entries) {
+	case 0:
+		if defaultName == "report.md" {
+			return "", nil
+		}
+		return defaultName, nil
+	case 1:
+		return entries[0], nil
+	default:
+		return "", fmt.Errorf("several templates could be the report (%s); name the main one %s", strings.Join(entries, ", "), defaultName)
+	}
+}
+
+// baseFuncs は HTML と Markdown のテンプレートに共通の関数
+func baseFuncs() map[string]any {
+	return map[string]any{
+		"add":          func(a, b int) int { return a + b },
+		"sub":          func(a, b int) int { return a - b },
+		"mul":          func(a, b int) int { return a * b },
+		"div":          divide,
+		"percent":      func(a, b int) float64 { return divide(a, b) * 100 },
+		"signed":       formatDiff,
+		"formatNumber": formatNumber,
+		"formatDate":   formatDate,
+		"join":         strings.Join,
+	}
+}
+
+func htmlFuncs() htmltemplate.FuncMap {
+	funcs := baseFuncs()
+	funcs["json"] = func(v interface{}) htmltemplate.JS {
+		b, _ := json.Marshal(v)
+		return htmltemplate.JS(b)
+	}
+	return funcs
+}
+
+func textFuncs() texttemplate.FuncMap {
+	funcs := baseFuncs()
+	funcs["json"] = func(v interface{}) string {
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+	return funcs
+}
+
+// divide は a / b を返す。b が0の場合は0を返す
+func divide(a, b int) float64 {
+	if b == 0 {
+		return 0
+	}
+	return float64(a) / float64(b)
+}
+
+// formatNumber は3桁ごとにカンマで区切った数値を返す (例: 12345 → 12,345)
+func formatNumber(n int) string {
+	s := strconv.Itoa(n)
+	sign := ""
+	if n < 0 {
+		sign, s = "-", s[1:]
+	}
+	for i := len(s) - 3; i > 0; i -= 3 {
+		s = s[:i] + "," + s[i:]
+	}
+	return sign + s
+}
+
+// formatDate は time.Time または "2006-01-02" 形式の日付を layout で整形する
+func formatDate(layout string, v any) (string, error) {
+	switch d := v.(type) {
+	case time.Time:
+		return d.Format(layout), nil
+	case *time.Time:
+		if d == nil {
+			return "", nil
+		}
+		return d.Format(layout), nil
+	case string:
+		t, err := time.Parse("2006-01-02", d)
+		if err != nil {
+			return "", fmt.Errorf("formatDate: %w", err)
+		}
+		return t.Format(layout), nil
+	default:
+		return "", fmt.Errorf("formatDate: unsupported type %T", v)
+	}
+}
@@ -0,0 +1,215 @@
+package render
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	apperrors "github.com/taikicoco/shiraberu/internal/errors"
+)
+
+func writeTemplateFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestLoadTemplate_OverridePartial(t *testing.T) {
+	dir := writeTemplateFiles(t, map[string]string{
+		"styles.html": `{{define "styles"}}<style>body { color: hotpink; }</style>{{end}}`,
+	})
+	tmpl, err := LoadTemplate(dir)
+	if err != nil {
+		t.Fatalf("LoadTemplate failed: %v", err)
+	}
+	if tmpl.markdown != nil {
+		t.Error("expected no Markdown template")
+	}
+
+	report, previous := markdownSectionsReport()
+	var buf bytes.Buffer
+	if err := RenderHTML(&buf, report, previous, WithTemplate(tmpl)); err != nil {
+		t.Fatalf("RenderHTML failed: %v", err)
+	}
+	got := buf.String()
+	if !strings.Contains(got, "color: hotpink") {
+		t.Error("expected overridden styles")
+	}
+	if !strings.Contains(got, "2025/01/20 〜 2025/02/09") {
+		t.Error("expected the rest of the built-in report")
+	}
+
+	// 上書きは組み込みのテンプレートに影響しない
+	buf.Reset()
+	if err := RenderHTML(&buf, report, previous); err != nil {
+		t.Fatalf("RenderHTML failed: %v", err)
+	}
+	if strings.Contains(buf.String(), "hotpink") {
+		t.Error("built-in template was modified")
+	}
+}
+
+func TestLoadTemplate_HTMLReport(t *testing.T) {
+	dir := writeTemplateFiles(t, map[string]string{
+		"mine.html.tmpl": `<h1>{{.PeriodLabel}}</h1><p>{{.Summary.MergedCount}} merged</p>{{template "styles" .}}`,
+	})
+	tmpl, err := LoadTemplate(filepath.Join(dir, "mine.html.tmpl"))
+	if err != nil {
+		t.Fatalf("LoadTemplate failed: %v", err)
+	}
+
+	report, previous := markdownSectionsReport()
+	var buf bytes.Buffer
+	if err := RenderHTML(&buf, report, previous, WithTemplate(tmpl)); err != nil {
+		t.Fatalf("RenderHTML failed: %v", err)
+	}
+	got := buf.String()
+	if !strings.HasPrefix(got, "<h1>2025/01/20 〜 2025/02/09</h1><p>3 merged</p>\n<style>") {
+		t.Errorf("unexpected output: %.100s", got)
+	}
+}
+
+func TestLoadTemplate_HTMLWithoutChartJS(t *testing.T) {
+	original := chartJSSource
+	t.Cleanup(func() { chartJSSource = original })
+	chartJSSource = ""
+
+	tests := []struct {
+		name    string
+		content string
+		wantErr bool
+	}{
+		{"not using Chart.js", `<h1>{{.PeriodLabel}}</h1>`, false},
+		{"using Chart.js", `<script>{{.ChartJS}}</script>`, true},
+	}
+
+	report, previous := markdownSectionsReport()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := writeTemplateFiles(t, map[string]string{"report.html": tt.content})
+			tmpl, err := LoadTemplate(dir)
+			if err != nil {
+				t.Fatalf("LoadTemplate failed: %v", err)
+			}
+
+			var buf bytes.Buffer
+			err = RenderHTML(&buf, report, previous, WithTemplate(tmpl), WithChartJS(ChartJSInline))
+			if tt.wantErr {
+				if !errors.Is(err, apperrors.ErrChartJSMissing) {
+					t.Errorf("expected ErrChartJSMissing, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("RenderHTML failed: %v", err)
+			}
+			if got := buf.String(); got != "<h1>2025/01/20 〜 2025/02/09</h1>" {
+				t.Errorf("unexpected output: %q", got)
+			}
+		})
+	}
+}
+
+func TestLoadTemplate_Markdown(t *testing.T) {
+	dir := writeTemplateFiles(t, map[string]string{
+		"report.md": `# {{.PeriodLabel}}
+Merged: {{.Summary.MergedCount}} ({{signed .SummaryDiff.MergedDiff}} vs {{.SummaryDiff.Label}})
+Lines: +{{formatNumber .Summary.Additions}} ({{printf "%.1f" (percent .Summary.MergedCount .Summary.OpenedCount)}}%)
+{{range .RepoStats}}{{template "repo" .}}{{end}}`,
+		"repo.md": `{{define "repo"}}- {{.Repository}}: {{.Count}}
+{{end}}`,
+	})
+	tmpl, err := LoadTemplate(dir)
+	if err != nil {
+		t.Fatalf("LoadTemplate failed: %v", err)
+	}
+
+	report, previous := markdownSectionsReport()
+	var buf bytes.Buffer
+	if err := RenderMarkdown(&buf, report, previous, WithTemplate(tmpl)); err != nil {
+		t.Fatalf("RenderMarkdown failed: %v", err)
+	}
+	want := `# 2025/01/20 〜 2025/02/09
+Merged: 3 (+2 vs previous period)
+Lines: +40 (300.0%)
+- test-org/api: 2
+- test-org/web: 1
+`
+	if got := buf.String(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+
+	// Markdown だけのテンプレートでは HTML は組み込みの出力のまま
+	buf.Reset()
+	if err := RenderHTML(&buf, report, previous, WithTemplate(tmpl)); err != nil {
+		t.Fatalf("RenderHTML failed: %v", err)
+	}
+	if !strings.Contains(buf.String(), "<!DOCTYPE html>") {
+		t.Error("expected the built-in HTML report")
+	}
+}
+
+func TestLoadTemplate_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+	}{
+		{"unsupported extension", map[string]string{"report.txt": "x"}},
+		{"parse error", map[string]string{"report.md": "{{.Summary"}},
+		{"ambiguous entry", map[string]string{"a.md": "a", "b.md": "b"}},
+		{"only partials", map[string]string{"partials.md": `{{define "x"}}x{{end}}`}},
+		{"empty directory", map[string]string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := writeTemplateFiles(t, tt.files)
+			if _, err := LoadTemplate(dir); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+
+	if _, err := LoadTemplate(filepath.Join(t.TempDir(), "missing.md")); err == nil {
+		t.Error("expected error for a missing file")
+	}
+}
+
+func TestTemplateFuncs(t *testing.T) {
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"formatNumber", formatNumber(1234567), "1,234,567"},
+		{"formatNumber small", formatNumber(999), "999"},
+		{"formatNumber negative", formatNumber(-12345), "-12,345"},
+		{"divide", divide(3, 2), 1.5},
+		{"divide by zero", divide(3, 0), 0.0},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+
+	date := time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC)
+	for _, v := range []any{date, &date, "2025-02-03"} {
+		got, err := formatDate("Jan 2", v)
+		if err != nil || got != "Feb 3" {
+			t.Errorf("formatDate(%T): got %q, %v", v, got, err)
+		}
+	}
+	if _, err := formatDate("Jan 2", 42); err == nil {
+		t.Error("formatDate(int): expected error")
+	}
+}
@@ -1,64 +1,198 @@
 package render
 
-import "github.com/taikicoco/shiraberu/internal/pr"
+import (
+	"fmt"
+	"html/template"
+	"strings"
+
+	"github.com/taikicoco/shiraberu/internal/pr"
+)
 
 // Summary はPRの集計データ
 type Summary struct {
-	OpenedCount   int
-	DraftCount    int
-	MergedCount   int
-	ReviewedCount int
-	Additions     int
-	Deletions     int
+	OpenedCount   int `json:"openedCount"`
+	DraftCount    int `json:"draftCount"`
+	MergedCount   int `json:"mergedCount"`
+	ClosedCount   int `json:"closedCount"`
+	ReviewedCount int `json:"reviewedCount"`
+	Additions     int `json:"additions"`
+	Deletions     int `json:"deletions"`
+	// レビュー結果の内訳（提出したレビュー単位）
+	ApprovedCount         int `json:"approvedCount"`
+	ChangesRequestedCount int `json:"changesRequestedCount"`
+	CommentedCount        int `json:"commentedCount"`
 }
 
-// SummaryDiff は前期間との差分
+// SummaryDiff は比較対象の期間（デフォルトは前期間）との差分
 type SummaryDiff struct {
-	OpenedDiff   int
-	DraftDiff    int
-	MergedDiff   int
-	ReviewedDiff int
-	HasPrevious  bool // 前期間データがあるかどうか
+	Label        string `json:"label"`  // 比較対象の表示名 (例: previous period)
+	Period       string `json:"period"` // 比較対象の期間 (例: 2024/12/01 〜 2024/12/31)
+	OpenedDiff   int    `json:"openedDiff"`
+	DraftDiff    int    `json:"draftDiff"`
+	MergedDiff   int    `json:"mergedDiff"`
+	ClosedDiff   int    `json:"closedDiff"`
+	ReviewedDiff int    `json:"reviewedDiff"`
+	HasPrevious  bool   `json:"hasPrevious"` // 前期間データがあるかどうか
+}
+
+// minTrendPeriods は推移を表示するのに必要な過去期間の数（1期間だけなら SummaryDiff で十分）
+const minTrendPeriods = 2
+
+// TrendStat は1つの指標の直近 N 期間の推移
+type TrendStat struct {
+	Values  []int   `json:"values"`  // 過去期間から今期までの値（古い順、最後が今期）
+	Average float64 `json:"average"` // 過去期間の平均（今期を含まない）
+	Min     int     `json:"min"`     // 過去期間の最小値
+	Max     int     `json:"max"`     // 過去期間の最大値
+}
+
+// SummaryTrend は直近 N 期間と比較した今期の集計
+type SummaryTrend struct {
+	Periods  int       `json:"periods"` // 比較した過去期間の数
+	Opened   TrendStat `json:"opened"`
+	Draft    TrendStat `json:"draft"`
+	Merged   TrendStat `json:"merged"`
+	Closed   TrendStat `json:"closed"`
+	Reviewed TrendStat `json:"reviewed"`
+}
+
+// HasTrend は推移を表示できるだけの過去期間があるかどうかを返す
+func (t SummaryTrend) HasTrend() bool {
+	return t.Periods >= minTrendPeriods
+}
+
+// Current は今期の値を返す
+func (t TrendStat) Current() int {
+	if len(t.Values) == 0 {
+		return 0
+	}
+	return t.Values[len(t.Values)-1]
+}
+
+// DiffFromAverage は今期の値と過去期間の平均との差を返す
+func (t TrendStat) DiffFromAverage() float64 {
+	return float64(t.Current()) - t.Average
+}
+
+// SparklinePoints は width x height の領域に収まる折れ線の座標を SVG の points 形式で返す
+func (t TrendStat) SparklinePoints(width, height int) string {
+	if len(t.Values) == 0 {
+		return ""
+	}
+
+	lo, hi := t.Values[0], t.Values[0]
+	for _, v := range t.Values {
+		lo = min(lo, v)
+		hi = max(hi, v)
+	}
+
+	points := make([]string, len(t.Values))
+	for i, v := range t.Values {
+		x := 0.0
+		if len(t.Values) > 1 {
+			x = float64(i) * float64(width) / float64(len(t.Values)-1)
+		}
+		y := float64(height) / 2
+		if hi > lo {
+			y = float64(height) - float64(v-lo)*float64(height)/float64(hi-lo)
+		}
+		points[i] = fmt.Sprintf("%.1f,%.1f", x, y)
+	}
+	return strings.Join(points, " ")
 }
 
 // DailyStat はグラフ用の日別統計データ
 type DailyStat struct {
-	Date          string // "2006-01-02" 形式
-	OpenedCount   int
-	DraftCount    int
-	MergedCount   int
-	ReviewedCount int
-	Additions     int
-	Deletions     int
-	TotalPRs      int // 日別詳細のサマリー表示用
+	Date          string `json:"date"` // "2006-01-02" 形式
+	OpenedCount   int    `json:"openedCount"`
+	DraftCount    int    `json:"draftCount"`
+	MergedCount   int    `json:"mergedCount"`
+	ClosedCount   int    `json:"closedCount"`
+	ReviewedCount int    `json:"reviewedCount"`
+	Additions     int    `json:"additions"`
+	Deletions     int    `json:"deletions"`
+	TotalPRs      int    `json:"totalPRs"` // 日別詳細のサマリー表示用
+	// レビュー結果の内訳（提出したレビュー単位）
+	ApprovedCount         int    `json:"approvedCount"`
+	ChangesRequestedCount int    `json:"changesRequestedCount"`
+	CommentedCount        int    `json:"commentedCount"`
+	IsBusinessDay         bool   `json:"isBusinessDay"`
+	HolidayName           string `json:"holidayName,omitempty"` // 休日の場合のみ設定される
+}
+
+// HolidayStat は期間内の休日
+type HolidayStat struct {
+	Date string `json:"date"` // "2006-01-02" 形式
+	Name string `json:"name"`
+}
+
+// BusinessDayStat は稼働日ベースの統計データ。
+// 休日と稼働曜日以外の日の活動は1日あたりの件数に含めない
+type BusinessDayStat struct {
+	BusinessDays   int           `json:"businessDays"`
+	Holidays       []HolidayStat `json:"holidays,omitempty"`
+	QuietDays      int           `json:"quietDays"` // 活動のなかった稼働日の数
+	OpenedPerDay   float64       `json:"openedPerDay"`
+	MergedPerDay   float64       `json:"mergedPerDay"`
+	ReviewedPerDay float64       `json:"reviewedPerDay"`
 }
 
 // WeeklyStat は週別統計データ
 type WeeklyStat struct {
-	Week          string // "1/1 〜 1/7" 形式
-	StartDate     string // "2006-01-02" 形式
-	EndDate       string // "2006-01-02" 形式
-	OpenedCount   int
-	DraftCount    int
-	MergedCount   int
-	ReviewedCount int
+	Week          string `json:"week"`      // "1/1 〜 1/7" 形式
+	StartDate     string `json:"startDate"` // "2006-01-02" 形式
+	EndDate       string `json:"endDate"`   // "2006-01-02" 形式
+	OpenedCount   int    `json:"openedCount"`
+	DraftCount    int    `json:"draftCount"`
+	MergedCount   int    `json:"mergedCount"`
+	ClosedCount   int    `json:"closedCount"`
+	ReviewedCount int    `json:"reviewedCount"`
 }
 
 // MonthlyStat は月別統計データ
 type MonthlyStat struct {
-	Month         string // "Jan 2006" 形式
-	StartDate     string // "2006-01-02" 形式
-	EndDate       string // "2006-01-02" 形式
-	OpenedCount   int
-	DraftCount    int
-	MergedCount   int
-	ReviewedCount int
+	Month         string `json:"month"`     // "Jan 2006" 形式
+	StartDate     string `json:"startDate"` // "2006-01-02" 形式
+	EndDate       string `json:"endDate"`   // "2006-01-02" 形式
+	OpenedCount   int    `json:"openedCount"`
+	DraftCount    int    `json:"draftCount"`
+	MergedCount   int    `json:"mergedCount"`
+	ClosedCount   int    `json:"closedCount"`
+	ReviewedCount int    `json:"reviewedCount"`
+}
+
+// QuarterlyStat は四半期別統計データ（会計年度の開始月は report.Calendar に従う）
+type QuarterlyStat struct {
+	Quarter       string `json:"quarter"`   // "2025 Q1" または "FY2025 Q1" 形式
+	StartDate     string `json:"startDate"` // "2006-01-02" 形式
+	EndDate       string `json:"endDate"`   // "2006-01-02" 形式
+	OpenedCount   int    `json:"openedCount"`
+	DraftCount    int    `json:"draftCount"`
+	MergedCount   int    `json:"mergedCount"`
+	ClosedCount   int    `json:"closedCount"`
+	ReviewedCount int    `json:"reviewedCount"`
 }
 
 // RepoStat はリポジトリ別の統計データ
 type RepoStat struct {
-	Repository string
-	Count      int
+	Repository string `json:"repository"`
+	Count      int    `json:"count"`
+}
+
+// OrgStat はOrganization別の統計データ
+type OrgStat struct {
+	Org           string `json:"org"`
+	OpenedCount   int    `json:"openedCount"`
+	DraftCount    int    `json:"draftCount"`
+	MergedCount   int    `json:"mergedCount"`
+	ClosedCount   int    `json:"closedCount"`
+	ReviewedCount int    `json:"reviewedCount"`
+}
+
+// LabelStat はラベル別の統計データ
+type LabelStat struct {
+	Label string `json:"label"`
+	Count int    `json:"count"`
 }
 
 // DayJSON はJavaScript用の日別データ
@@ -67,6 +201,7 @@ type DayJSON struct {
 	Opened   []PRJSON `json:"opened"`
 	Draft    []PRJSON `json:"draft"`
 	Merged   []PRJSON `json:"merged"`
+	Closed   []PRJSON `json:"closed"`
 	Reviewed []PRJSON `json:"reviewed"`
 }
 
@@ -80,6 +215,10 @@ type PRJSON struct {
 	Additions  int    `json:"additions"`
 	Deletions  int    `json:"deletions"`
 	Comments   int    `json:"comments"`
+	// Labels はPRに付与されたラベル名
+	Labels []string `json:"labels,omitempty"`
+	// ReviewStates はレビューした PR に対して提出したレビューの状態
+	ReviewStates []string `json:"reviewStates,omitempty"`
 }
 
 // HTMLData はHTMLテンプレート用のデータ
@@ -87,13 +226,53 @@ type HTMLData struct {
 	Report            *pr.Report
 	Summary           Summary
 	SummaryDiff       SummaryDiff
+	SummaryTrend      SummaryTrend // 過去期間が2つ以上ある場合のみ表示される
 	DailyStats        []DailyStat
 	WeeklyStats       []WeeklyStat
 	MonthlyStats      []MonthlyStat
+	QuarterlyStats    []QuarterlyStat
 	RepoStats         []RepoStat
+	LabelStats        []LabelStat
+	OrgStats          []OrgStat // 複数Organizationの場合のみ設定される
+	BusinessDayStat   BusinessDayStat
+	NonBusinessDays   map[string]string // 稼働日でない日付 → 休日名（休日でない場合は空文字）
 	Weekdays          []string
+	WeekStart         int // 週の開始曜日（0: 日曜 〜 6: 土曜）
 	PeriodLabel       string
 	DaysJSON          []DayJSON
 	OriginalStartDate string
 	OriginalEndDate   string
+
+	chartJS ChartJSMode
+}
+
+// ChartJS は HTML に埋め込む Chart.js を返す。空の場合は ChartJSURL から読み込む。
+// テンプレートで使用された時に解決するため、Chart.js を使わないテンプレートは埋め込みのビルドがなくても出力できる
+func (d HTMLData) ChartJS() (template.JS, error) {
+	js, _, err := chartJSScript(d.chartJS)
+	return js, err
+}
+
+// ChartJSURL は Chart.js を CDN から読み込む場合の URL を返す。埋め込む場合は空
+func (d HTMLData) ChartJSURL() (string, error) {
+	_, url, err := chartJSScript(d.chartJS)
+	return url, err
+}
+
+// MarkdownData はユーザー指定の Markdown テンプレートに渡すデータ
+type MarkdownData struct {
+	Report          *pr.Report
+	PreviousReport  *pr.Report // 比較対象の期間のレポート。取得できなかった場合は nil
+	Summary         Summary
+	SummaryDiff     SummaryDiff
+	SummaryTrend    SummaryTrend
+	DailyStats      []DailyStat
+	WeeklyStats     []WeeklyStat
+	MonthlyStats    []MonthlyStat
+	QuarterlyStats  []QuarterlyStat
+	RepoStats       []RepoStat
+	LabelStats      []LabelStat
+	OrgStats        []OrgStat // 複数Organizationの場合のみ設定される
+	BusinessDayStat BusinessDayStat
+	PeriodLabel     string
 }
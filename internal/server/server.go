@@ -46,6 +46,7 @@ func (o *DefaultBrowserOpener) Open(url string) error {
 // Server はHTTPサーバーの設定を保持する
 type Server struct {
 	browserOpener BrowserOpener
+	renderOptions []render.Option
 }
 
 // ServerOption はServerの設定オプション
@@ -58,6 +59,13 @@ func WithBrowserOpener(opener BrowserOpener) ServerOption {
 	}
 }
 
+// WithRenderOptions はHTMLレンダリング時のオプションを設定する
+func WithRenderOptions(opts ...render.Option) ServerOption {
+	return func(s *Server) {
+		s.renderOptions = opts
+	}
+}
+
 // NewServer は新しいServerインスタンスを作成する
 func NewServer(opts ...ServerOption) *Server {
 	s := &Server{
@@ -88,11 +96,11 @@ func ServeWithAddr(report *pr.Report, previousReport *pr.Report, addr string) er
 	return NewServer().ServeWithAddr(report, previousReport, addr)
 }
 
-// ServeWithAddr は指定アドレスでサーバーを起動する
-func (s *Server) ServeWithAddr(report *pr.Report, previousReport *pr.Report, addr string) error {
+// Handler はレポートのHTMLを返すハンドラーを作成する。HTMLは作成時に一度だけレンダリングする
+func (s *Server) Handler(report *pr.Report, previousReport *pr.Report) (http.Handler, error) {
 	var buf bytes.Buffer
-	if err := render.RenderHTML(&buf, report, previousReport); err != nil {
-		return err
+	if err := render.RenderHTML(&buf, report, previousReport, s.renderOptions...); err != nil {
+		return nil, err
 	}
 	content := buf.Bytes()
 
@@ -101,10 +109,19 @@ func (s *Server) ServeWithAddr(report *pr.Report, previousReport *pr.Report, add
 		w.Header().Set("Content-Type", "text/html; charset=utf-8")
 		_, _ = w.Write(content)
 	})
+	return mux, nil
+}
+
+// ServeWithAddr は指定アドレスでサーバーを起動する
+func (s *Server) ServeWithAddr(report *pr.Report, previousReport *pr.Report, addr string) error {
+	handler, err := s.Handler(report, previousReport)
+	if err != nil {
+		return err
+	}
 
 	server := &http.Server{
 		Addr:    addr,
-		Handler: mux,
+		Handler: handler,
 	}
 
 	go func() {
@@ -31,7 +31,7 @@ func TestHTTPHandler(t *testing.T) {
 		GeneratedAt: time.Date(2025, 1, 15, 10, 30, 0, 0, timezone.JST),
 		StartDate:   time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST),
 		EndDate:     time.Date(2025, 1, 15, 0, 0, 0, 0, timezone.JST),
-		Org:         "test-org",
+		Orgs:        []string{"test-org"},
 		Days: []pr.DailyPRs{
 			{
 				Date: time.Date(2025, 1, 10, 0, 0, 0, 0, timezone.JST),
@@ -88,7 +88,7 @@ func TestHTTPHandler_EmptyReport(t *testing.T) {
 		GeneratedAt: time.Date(2025, 1, 15, 10, 30, 0, 0, timezone.JST),
 		StartDate:   time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST),
 		EndDate:     time.Date(2025, 1, 15, 0, 0, 0, 0, timezone.JST),
-		Org:         "test-org",
+		Orgs:        []string{"test-org"},
 		Days:        []pr.DailyPRs{},
 	}
 
@@ -133,6 +133,44 @@ func TestNewServer_WithBrowserOpener(t *testing.T) {
 	}
 }
 
+func TestNewServer_WithRenderOptions(t *testing.T) {
+	report := &pr.Report{
+		GeneratedAt: time.Date(2025, 1, 15, 10, 30, 0, 0, timezone.JST),
+		StartDate:   time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST),
+		EndDate:     time.Date(2025, 1, 15, 0, 0, 0, 0, timezone.JST),
+		Orgs:        []string{"test-org"},
+		Days: []pr.DailyPRs{
+			{
+				Date:   time.Date(2025, 1, 10, 0, 0, 0, 0, timezone.JST),
+				Merged: []github.PullRequest{{Title: "Feature", Repository: "test-org/api"}},
+			},
+		},
+	}
+	yearAgo := &pr.Report{
+		StartDate: time.Date(2024, 1, 1, 0, 0, 0, 0, timezone.JST),
+		EndDate:   time.Date(2024, 1, 15, 0, 0, 0, 0, timezone.JST),
+	}
+
+	s := NewServer(WithRenderOptions(render.WithBaselineLabel("same period last year")))
+	handler, err := s.Handler(report, yearAgo)
+	if err != nil {
+		t.Fatalf("Handler failed: %v", err)
+	}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL)
+	if err != nil {
+		t.Fatalf("GET failed: %v", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, _ := io.ReadAll(resp.Body)
+	if !strings.Contains(string(body), "Compared with same period last year (2024/01/01 〜 2024/01/15)") {
+		t.Error("Response should reflect the render options (baseline label)")
+	}
+}
+
 func TestServer_ServeWithAddr(t *testing.T) {
 	mock := &MockBrowserOpener{}
 	s := NewServer(WithBrowserOpener(mock))
@@ -141,7 +179,7 @@ func TestServer_ServeWithAddr(t *testing.T) {
 		GeneratedAt: time.Date(2025, 1, 15, 10, 30, 0, 0, timezone.JST),
 		StartDate:   time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST),
 		EndDate:     time.Date(2025, 1, 15, 0, 0, 0, 0, timezone.JST),
-		Org:         "test-org",
+		Orgs:        []string{"test-org"},
 		Days:        []pr.DailyPRs{},
 	}
 
@@ -170,7 +208,7 @@ func TestServeWithAddr_Integration(t *testing.T) {
 		GeneratedAt: time.Date(2025, 1, 15, 10, 30, 0, 0, timezone.JST),
 		StartDate:   time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST),
 		EndDate:     time.Date(2025, 1, 15, 0, 0, 0, 0, timezone.JST),
-		Org:         "integration-test-org",
+		Orgs:        []string{"integration-test-org"},
 		Days: []pr.DailyPRs{
 			{
 				Date: time.Date(2025, 1, 10, 0, 0, 0, 0, timezone.JST),
@@ -229,7 +267,7 @@ func TestServe(t *testing.T) {
 		GeneratedAt: time.Date(2025, 1, 15, 10, 30, 0, 0, timezone.JST),
 		StartDate:   time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST),
 		EndDate:     time.Date(2025, 1, 15, 0, 0, 0, 0, timezone.JST),
-		Org:         "test-org",
+		Orgs:        []string{"test-org"},
 		Days:        []pr.DailyPRs{},
 	}
 
@@ -0,0 +1,85 @@
+// Package slack は Slack の Incoming Webhook へのメッセージ送信を提供する
+package slack
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	apperrors "github.com/taikicoco/shiraberu/internal/errors"
+)
+
+const webhookTimeout = 30 * time.Second
+
+// Webhook は Incoming Webhook の URL にメッセージを POST するクライアント
+type Webhook struct {
+	url        string
+	httpClient *http.Client
+}
+
+// WebhookOption は Webhook の設定オプション
+type WebhookOption func(*Webhook)
+
+// WithHTTPClient は送信に使用する http.Client を設定するオプション
+func WithHTTPClient(c *http.Client) WebhookOption {
+	return func(w *Webhook) {
+		w.httpClient = c
+	}
+}
+
+// NewWebhook は新しい Webhook を作成する
+func NewWebhook(webhookURL string, opts ...WebhookOption) *Webhook {
+	w := &Webhook{
+		url:        webhookURL,
+		httpClient: &http.Client{Timeout: webhookTimeout},
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w
+}
+
+// Post は JSON のペイロード（render.RenderSlack の出力など）を送信する
+func (w *Webhook) Post(payload []byte) error {
+	req, err := http.NewRequest(http.MethodPost, w.url, bytes.NewReader(payload))
+	if err != nil {
+		// URL は秘密情報のため、エラーメッセージに含めない
+		return fmt.Errorf("%w: invalid webhook URL", apperrors.ErrWebhookFailed)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.httpClient.Do(req)
+	if err != nil {
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return fmt.Errorf("%w: %v", apperrors.ErrWebhookFailed, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		// Slack はエラーの理由（invalid_payload など）を本文で返す
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("%w: %s\n%s", apperrors.ErrWebhookFailed, resp.Status, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// PostText は mrkdwn のテキストを {"text": ...} のペイロードとして送信する
+func (w *Webhook) PostText(text string) error {
+	// mrkdwn のリンク (<url|text>) を読みやすいまま送るため、HTML のエスケープはしない
+	var payload bytes.Buffer
+	enc := json.NewEncoder(&payload)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(map[string]string{"text": text}); err != nil {
+		return err
+	}
+	return w.Post(payload.Bytes())
+}
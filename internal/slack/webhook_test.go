@@ -0,0 +1,67 @@
+package slack
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apperrors "github.com/taikicoco/shiraberu/internal/errors"
+)
+
+func TestWebhook_Post(t *testing.T) {
+	var gotBody, gotContentType, gotMethod string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		gotBody, gotContentType, gotMethod = string(b), r.Header.Get("Content-Type"), r.Method
+		io.WriteString(w, "ok")
+	}))
+	defer srv.Close()
+
+	webhook := NewWebhook(srv.URL+"/services/T000/B000/XXX", WithHTTPClient(srv.Client()))
+	if err := webhook.Post([]byte(`{"text":"hi"}`)); err != nil {
+		t.Fatalf("Post failed: %v", err)
+	}
+	if gotMethod != http.MethodPost || gotContentType != "application/json" || gotBody != `{"text":"hi"}` {
+		t.Errorf("unexpected request: %s %s %s", gotMethod, gotContentType, gotBody)
+	}
+
+	if err := webhook.PostText("*Merged:* 3 <https://example.com|PR>"); err != nil {
+		t.Fatalf("PostText failed: %v", err)
+	}
+	if want := `{"text":"*Merged:* 3 <https://example.com|PR>"}`; strings.TrimSpace(gotBody) != want {
+		t.Errorf("PostText body: got %s, want %s", gotBody, want)
+	}
+}
+
+func TestWebhook_Post_Error(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		io.WriteString(w, "invalid_payload")
+	}))
+	defer srv.Close()
+
+	err := NewWebhook(srv.URL).Post([]byte(`{}`))
+	if !errors.Is(err, apperrors.ErrWebhookFailed) {
+		t.Fatalf("expected ErrWebhookFailed, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "invalid_payload") {
+		t.Errorf("expected the reason from Slack, got %v", err)
+	}
+}
+
+func TestWebhook_Post_HidesURL(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	webhookURL := srv.URL + "/services/T000/B000/secret"
+	srv.Close() // 接続に失敗させる
+
+	err := NewWebhook(webhookURL).Post([]byte(`{}`))
+	if !errors.Is(err, apperrors.ErrWebhookFailed) {
+		t.Fatalf("expected ErrWebhookFailed, got %v", err)
+	}
+	if strings.Contains(err.Error(), "secret") {
+		t.Errorf("error leaks the webhook URL: %v", err)
+	}
+}
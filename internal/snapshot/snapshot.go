@@ -0,0 +1,63 @@
+// Package snapshot は取得済みのレポートをファイルに保存し、GitHub にアクセスせずに再出力できるようにする
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	apperrors "github.com/taikicoco/shiraberu/internal/errors"
+	"github.com/taikicoco/shiraberu/internal/pr"
+)
+
+// Version はスナップショットのファイル形式のバージョン。
+// 読み込めない形式に変更する場合に上げる
+const Version = 1
+
+// Snapshot は再出力に必要な取得済みのレポート一式
+type Snapshot struct {
+	Version int        `json:"version"`
+	SavedAt time.Time  `json:"savedAt"`
+	Report  *pr.Report `json:"report"`
+	// Previous は比較対象の期間のレポート（取得できなかった場合は nil）
+	Previous *pr.Report `json:"previous"`
+	// History は推移の比較に使用した直近の過去期間のレポート（新しい順、取得できなかった期間は nil）
+	History []*pr.Report `json:"history,omitempty"`
+	// BaselineLabel は Previous の表示名（例: same period last year）
+	BaselineLabel string `json:"baselineLabel,omitempty"`
+}
+
+// Save はスナップショットを path に JSON 形式で保存する
+func Save(path string, s *Snapshot) error {
+	s.Version = Version
+	if s.SavedAt.IsZero() {
+		s.SavedAt = time.Now()
+	}
+
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// Load は Save で保存したスナップショットを読み込む
+func Load(path string) (*Snapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var s Snapshot
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("%w: %s: %v", apperrors.ErrInvalidSnapshot, path, err)
+	}
+	if s.Version != Version {
+		return nil, fmt.Errorf("%w: %s: unsupported version %d (want %d)", apperrors.ErrInvalidSnapshot, path, s.Version, Version)
+	}
+	if s.Report == nil {
+		return nil, fmt.Errorf("%w: %s: no report", apperrors.ErrInvalidSnapshot, path)
+	}
+	return &s, nil
+}
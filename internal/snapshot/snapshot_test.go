@@ -0,0 +1,126 @@
+package snapshot
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	apperrors "github.com/taikicoco/shiraberu/internal/errors"
+	"github.com/taikicoco/shiraberu/internal/github"
+	"github.com/taikicoco/shiraberu/internal/period"
+	"github.com/taikicoco/shiraberu/internal/pr"
+	"github.com/taikicoco/shiraberu/internal/render"
+	"github.com/taikicoco/shiraberu/internal/timezone"
+)
+
+func testSnapshot() *Snapshot {
+	mergedAt := time.Date(2025, 1, 2, 15, 0, 0, 0, timezone.JST)
+	report := &pr.Report{
+		GeneratedAt: time.Date(2025, 1, 7, 9, 0, 0, 0, timezone.JST),
+		StartDate:   time.Date(2025, 1, 1, 0, 0, 0, 0, timezone.JST),
+		EndDate:     time.Date(2025, 1, 7, 0, 0, 0, 0, timezone.JST),
+		Host:        "github.com",
+		Timezone:    "Asia/Tokyo",
+		Orgs:        []string{"org-a", "org-b"},
+		Username:    "testuser",
+		Days: []pr.DailyPRs{
+			{
+				Date: time.Date(2025, 1, 2, 0, 0, 0, 0, timezone.JST),
+				Merged: []github.PullRequest{
+					{Title: "Feature", URL: "https://github.com/org-a/repo/pull/1", Repository: "org-a/repo", State: "merged", MergedAt: &mergedAt, Additions: 10, Labels: []string{"feature"}},
+				},
+				Reviewed: []github.PullRequest{
+					{Title: "Fix", Repository: "org-b/repo", State: "open", Reviews: []github.Review{{State: github.ReviewApproved, SubmittedAt: mergedAt}}},
+				},
+			},
+		},
+		Warnings:    []string{"partial results"},
+		LabelFilter: pr.LabelFilter{Exclude: []string{"chore"}},
+		Calendar:    &period.Calendar{WeekStart: time.Sunday, FiscalYearStart: time.April},
+	}
+	previous := &pr.Report{
+		StartDate: time.Date(2024, 12, 25, 0, 0, 0, 0, timezone.JST),
+		EndDate:   time.Date(2024, 12, 31, 0, 0, 0, 0, timezone.JST),
+		Orgs:      []string{"org-a", "org-b"},
+	}
+	return &Snapshot{
+		Report:        report,
+		Previous:      previous,
+		History:       []*pr.Report{previous, nil},
+		BaselineLabel: "previous period",
+	}
+}
+
+func TestSaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+	want := testSnapshot()
+	if err := Save(path, want); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	got, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if got.Version != Version || got.SavedAt.IsZero() {
+		t.Errorf("Version/SavedAt: got %d / %v", got.Version, got.SavedAt)
+	}
+	if got.BaselineLabel != want.BaselineLabel || len(got.History) != 2 || got.History[1] != nil {
+		t.Errorf("History/BaselineLabel: got %v / %q", got.History, got.BaselineLabel)
+	}
+	if *got.Report.Calendar != *want.Report.Calendar {
+		t.Errorf("Calendar: got %+v, want %+v", got.Report.Calendar, want.Report.Calendar)
+	}
+
+	// Every renderer produces the same output from the loaded snapshot
+	renderers := map[string]func(*bytes.Buffer, *Snapshot) error{
+		"html": func(b *bytes.Buffer, s *Snapshot) error {
+			return render.RenderHTML(b, s.Report, s.Previous, render.WithHistory(s.History...))
+		},
+		"markdown": func(b *bytes.Buffer, s *Snapshot) error { return render.RenderMarkdown(b, s.Report, s.Previous) },
+		"json":     func(b *bytes.Buffer, s *Snapshot) error { return render.RenderJSON(b, s.Report, s.Previous) },
+		"csv":      func(b *bytes.Buffer, s *Snapshot) error { return render.RenderCSV(b, s.Report) },
+	}
+	for name, renderFn := range renderers {
+		t.Run(name, func(t *testing.T) {
+			var before, after bytes.Buffer
+			if err := renderFn(&before, want); err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			if err := renderFn(&after, got); err != nil {
+				t.Fatalf("render from snapshot failed: %v", err)
+			}
+			if before.String() != after.String() {
+				t.Errorf("%s output differs after a snapshot round trip", name)
+			}
+		})
+	}
+}
+
+func TestLoad_Errors(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"invalid json": "{",
+		"old version":  `{"version": 0, "report": {}}`,
+		"no report":    `{"version": 1}`,
+	}
+
+	for name, content := range files {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(dir, name+".json")
+			if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := Load(path); !errors.Is(err, apperrors.ErrInvalidSnapshot) {
+				t.Errorf("error: got %v, want ErrInvalidSnapshot", err)
+			}
+		})
+	}
+
+	if _, err := Load(filepath.Join(dir, "missing.json")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("error: got %v, want os.ErrNotExist", err)
+	}
+}
@@ -24,7 +24,7 @@ type Spinner struct {
 func New(message string) *Spinner {
 	return &Spinner{
 		message: message,
-		writer:  os.Stdout,
+		writer:  os.Stderr, // 標準出力へのレポート出力と混ざらないようにする
 		stop:    make(chan struct{}),
 		done:    make(chan struct{}),
 	}
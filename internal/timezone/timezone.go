@@ -1,6 +1,36 @@
 package timezone
 
-import "time"
+import (
+	"fmt"
+	"os"
+	"time"
+)
 
 // JST は日本標準時 (UTC+9) のタイムゾーン
 var JST = time.FixedZone("JST", 9*60*60)
+
+// Load はIANAタイムゾーン名 (例: Europe/Berlin) からLocationを取得する。
+// 空文字または "Local" の場合はローカルタイムゾーンを返す
+func Load(name string) (*time.Location, error) {
+	if name == "" || name == "Local" {
+		return time.Local, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown timezone %q: %w", name, err)
+	}
+	return loc, nil
+}
+
+// Name はLocationの表示名を返す。
+// ローカルタイムゾーンの場合は TZ 環境変数、未設定なら t 時点のゾーン略称 (例: CET) を返す
+func Name(loc *time.Location, t time.Time) string {
+	if loc != time.Local {
+		return loc.String()
+	}
+	if tz := os.Getenv("TZ"); tz != "" {
+		return tz
+	}
+	name, _ := t.In(loc).Zone()
+	return name
+}
@@ -47,3 +47,51 @@ func TestJST_DateBoundary(t *testing.T) {
 		t.Errorf("JST hour: got %d, want 0", jst.Hour())
 	}
 }
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    string
+		wantErr bool
+	}{
+		{"", "Local", false},
+		{"Local", "Local", false},
+		{"UTC", "UTC", false},
+		{"Europe/Berlin", "Europe/Berlin", false},
+		{"America/Los_Angeles", "America/Los_Angeles", false},
+		{"Mars/Olympus_Mons", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc, err := Load(tt.name)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Load(%q) should fail", tt.name)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Load(%q) failed: %v", tt.name, err)
+			}
+			if loc.String() != tt.want {
+				t.Errorf("Load(%q): got %q, want %q", tt.name, loc.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestName(t *testing.T) {
+	berlin, err := Load("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if got := Name(berlin, time.Now()); got != "Europe/Berlin" {
+		t.Errorf("Name: got %q, want %q", got, "Europe/Berlin")
+	}
+
+	t.Setenv("TZ", "Asia/Tokyo")
+	if got := Name(time.Local, time.Now()); got != "Asia/Tokyo" {
+		t.Errorf("Name(Local) with TZ: got %q, want %q", got, "Asia/Tokyo")
+	}
+}
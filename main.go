@@ -1,6 +1,6 @@
 // Shiraberu is a CLI tool that generates pull request activity reports
 // from GitHub. It fetches merged PRs for a specified user and organization,
-// then renders the results as HTML or Markdown reports.
+// then renders the results as HTML, Markdown, JSON, CSV or Slack reports.
 //
 // Usage:
 //
@@ -8,28 +8,95 @@
 //
 // Flags:
 //
-//	-demo    Run with demo data (no GitHub API calls)
+//	-demo           Run with demo data (no GitHub API calls)
+//	-org string     Organization(s), comma separated (default: SHIRABERU_ORG)
+//	-user string    GitHub username (default: authenticated user)
+//	-period string  Period preset (today, yesterday, this-week, last-week, this-month, last-month,
+//	                this-quarter, last-quarter, this-half, last-half, this-fiscal-year, last-fiscal-year)
+//	                or expression (last-7d, last-2w, 2025-Q3, 2025-05, 2025-W18, since 2025-04-01, ...)
+//	-from string    Start date (YYYY-MM-DD)
+//	-to string      End date (YYYY-MM-DD, default: today)
+//	-format string  Output format: browser, html, markdown, json, csv, tsv, slack, slack-mrkdwn
+//	                (default: SHIRABERU_FORMAT)
+//	-output string  Output file path ("-" for stdout)
+//	-daily-csv path Also write the daily stats as CSV (TSV with -format tsv) to this path ("-" for stdout)
+//	-sections list  Markdown sections: summary, charts, orgs, repos, weekly, monthly, days (default: all)
+//	-charts mode    SVG charts in Markdown: none, files (saved next to the report) or inline (default: none)
+//	-chartjs mode   How HTML reports load Chart.js: inline (works offline) or cdn
+//	                (default: inline, or cdn in a binary built without the embedded Chart.js)
+//	-template path  Template file or directory (*.html, *.md) for HTML and Markdown output
+//	-slack-webhook url
+//	                Post the Slack output to this incoming-webhook URL (default: SHIRABERU_SLACK_WEBHOOK_URL)
+//	-compare string Comparison baseline: previous, year-ago or a period expression (default: SHIRABERU_COMPARE)
+//	-trend int      Number of previous periods to compare against (default: SHIRABERU_TREND_PERIODS or 1)
+//	-tz string      IANA timezone for day boundaries (default: SHIRABERU_TIMEZONE or local)
+//	-save-snapshot path
+//	                Save the fetched reports for re-rendering with -snapshot
+//	-snapshot path  Render a saved snapshot without accessing GitHub (with any -format)
+//
+// When the organization and the period are given by flags or environment
+// variables, the interactive prompt is skipped.
 package main
 
 import (
+	"bytes"
+	"errors"
 	"flag"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/taikicoco/shiraberu/internal/config"
 	"github.com/taikicoco/shiraberu/internal/demo"
+	apperrors "github.com/taikicoco/shiraberu/internal/errors"
 	"github.com/taikicoco/shiraberu/internal/github"
 	"github.com/taikicoco/shiraberu/internal/period"
 	"github.com/taikicoco/shiraberu/internal/pr"
 	"github.com/taikicoco/shiraberu/internal/prompt"
 	"github.com/taikicoco/shiraberu/internal/render"
 	"github.com/taikicoco/shiraberu/internal/server"
+	"github.com/taikicoco/shiraberu/internal/slack"
+	"github.com/taikicoco/shiraberu/internal/snapshot"
 	"github.com/taikicoco/shiraberu/internal/spinner"
+	"github.com/taikicoco/shiraberu/internal/timezone"
+
+	// タイムゾーンデータベースのない環境でも -tz / SHIRABERU_TIMEZONE を解決できるよう埋め込む
+	_ "time/tzdata"
+)
+
+var (
+	demoMode    = flag.Bool("demo", false, "Run with demo data (no GitHub API calls)")
+	compareFlag = flag.String("compare", "", `Comparison baseline: "previous", "year-ago" or a period expression (default: SHIRABERU_COMPARE or previous)`)
+	trendFlag   = flag.Int("trend", 0, "Number of previous periods to compare against (default: SHIRABERU_TREND_PERIODS or 1)")
+	tzFlag      = flag.String("tz", "", "IANA timezone for day boundaries, e.g. America/New_York (default: SHIRABERU_TIMEZONE or local)")
+	dailyCSV    = flag.String("daily-csv", "", `Also write the daily stats as CSV (TSV with -format tsv) to this path ("-" for stdout)`)
+	sections    = flag.String("sections", "", "Markdown sections to include, comma separated: summary, charts, orgs, repos, weekly, monthly, days (default: SHIRABERU_MARKDOWN_SECTIONS or all)")
+	chartsFlag  = flag.String("charts", "", `SVG charts in Markdown output: "none", "files" (saved next to the report) or "inline" (default: SHIRABERU_MARKDOWN_CHARTS or none)`)
+	chartJSFlag = flag.String("chartjs", "", `How HTML reports load Chart.js: "inline" (works offline) or "cdn" (default: SHIRABERU_CHARTJS, or inline when the binary embeds Chart.js and cdn otherwise)`)
+	templateArg = flag.String("template", "", "Template file or directory (*.html, *.md) for HTML and Markdown output (default: SHIRABERU_TEMPLATE or built-in)")
+
+	slackWebhook = flag.String("slack-webhook", "", "Post the Slack output (-format slack or slack-mrkdwn, default slack) to this incoming-webhook URL (default: SHIRABERU_SLACK_WEBHOOK_URL)")
+	snapshotFile = flag.String("snapshot", "", "Render a snapshot saved with -save-snapshot instead of fetching from GitHub")
+	saveSnapshot = flag.String("save-snapshot", "", "Save the fetched reports to this path for re-rendering with -snapshot")
+
+	cliFlags prompt.Flags
+
+	// reportTemplate は -template / SHIRABERU_TEMPLATE で指定されたテンプレート（未指定の場合は nil）
+	reportTemplate *render.Template
 )
 
-var demoMode = flag.Bool("demo", false, "Run with demo data (no GitHub API calls)")
+func init() {
+	flag.StringVar(&cliFlags.Org, "org", "", "Organization(s), comma separated (default: SHIRABERU_ORG)")
+	flag.StringVar(&cliFlags.Username, "user", "", "GitHub username (default: authenticated user)")
+	flag.StringVar(&cliFlags.Period, "period", "", "Period preset ("+strings.Join(period.Presets, ", ")+") or expression ("+period.ExpressionExamples+")")
+	flag.StringVar(&cliFlags.From, "from", "", "Start date (YYYY-MM-DD)")
+	flag.StringVar(&cliFlags.To, "to", "", "End date (YYYY-MM-DD, default: today)")
+	flag.StringVar(&cliFlags.Format, "format", "", "Output format: browser, html, markdown, json, csv, tsv, slack, slack-mrkdwn (default: SHIRABERU_FORMAT)")
+	flag.StringVar(&cliFlags.Output, "output", "", `Output file path ("-" for stdout)`)
+}
 
 func main() {
 	flag.Parse()
@@ -41,64 +108,354 @@ func main() {
 }
 
 func run() error {
-	// Demo mode
-	if *demoMode {
-		return runDemo()
-	}
-
 	cfg, err := config.Load()
 	if err != nil {
 		return fmt.Errorf("failed to load config: %w", err)
 	}
+	if *tzFlag != "" {
+		loc, err := timezone.Load(*tzFlag)
+		if err != nil {
+			return fmt.Errorf("%w: --tz: %v", apperrors.ErrInvalidOption, err)
+		}
+		if err := cfg.SetLocation(*tzFlag, loc); err != nil {
+			return fmt.Errorf("failed to load config: %w", err)
+		}
+	}
+	if *trendFlag < 0 {
+		return fmt.Errorf("%w: --trend must be a positive integer, got %d", apperrors.ErrInvalidOption, *trendFlag)
+	}
+	if *trendFlag > 0 {
+		cfg.TrendPeriods = *trendFlag
+	}
+	if *compareFlag != "" {
+		cfg.Compare = *compareFlag
+	}
+	if *sections != "" {
+		cfg.MarkdownSections = *sections
+	}
+	if _, err := render.ParseMarkdownSections(cfg.MarkdownSections); err != nil {
+		return fmt.Errorf("%w: --sections: %v", apperrors.ErrInvalidOption, err)
+	}
+	if *chartsFlag != "" {
+		cfg.MarkdownCharts = *chartsFlag
+	}
+	if _, err := render.ParseChartMode(cfg.MarkdownCharts); err != nil {
+		return fmt.Errorf("%w: --charts: %v", apperrors.ErrInvalidOption, err)
+	}
+	if *chartJSFlag != "" {
+		cfg.ChartJS = *chartJSFlag
+	}
+	if _, err := render.ParseChartJSMode(cfg.ChartJS); err != nil {
+		return fmt.Errorf("%w: --chartjs: %v", apperrors.ErrInvalidOption, err)
+	}
+	if *templateArg != "" {
+		cfg.Template = *templateArg
+	}
+	if cfg.Template != "" {
+		if reportTemplate, err = render.LoadTemplate(cfg.Template); err != nil {
+			return fmt.Errorf("%w: --template: %v", apperrors.ErrInvalidOption, err)
+		}
+	}
+	if *slackWebhook != "" {
+		switch cliFlags.Format {
+		case "":
+			cliFlags.Format = "slack"
+		case "slack", "slack-mrkdwn":
+		default:
+			return fmt.Errorf("%w: --slack-webhook requires --format slack or slack-mrkdwn", apperrors.ErrInvalidOption)
+		}
+		cfg.SlackWebhookURL = *slackWebhook
+	}
+	// Demo mode
+	if *demoMode {
+		return runDemo(cfg)
+	}
+	if *snapshotFile != "" {
+		return runSnapshot(cfg)
+	}
 
-	client, err := github.NewClient()
+	client, err := github.NewClient(
+		github.WithHost(cfg.GitHubHost),
+		github.WithToken(cfg.GitHubToken),
+		github.WithBaseURL(cfg.GitHubAPIURL),
+	)
 	if err != nil {
 		return fmt.Errorf("failed to create GitHub client: %w", err)
 	}
 
-	defaultUsername := client.Username()
-	opts, err := prompt.Run(cfg, defaultUsername)
+	opts, err := resolveOptions(cfg, client.Username())
 	if err != nil {
 		return err
 	}
 
-	fetcher := pr.NewFetcher(client)
+	comparison := period.ParseComparison(cfg.Compare)
+	baseStart, baseEnd, err := cfg.Calendar.Range(comparison, opts.StartDate, opts.EndDate, opts.PeriodType, time.Now().In(cfg.Location))
+	if err != nil {
+		return fmt.Errorf("%w: --compare: %w", apperrors.ErrInvalidOption, err)
+	}
+
+	fetcher := pr.NewFetcher(client,
+		pr.WithDraftMode(pr.DraftMode(cfg.DraftMode)),
+		pr.WithLabelFilter(pr.LabelFilter{Include: cfg.IncludeLabels, Exclude: cfg.ExcludeLabels}),
+		pr.WithLocation(cfg.Location),
+		pr.WithCalendar(cfg.Calendar),
+	)
 
 	// Fetch current period with spinner
 	spin := spinner.New("Fetching PRs...")
 	spin.Start()
-	report, err := fetcher.Fetch(opts.Org, opts.Username, opts.StartDate, opts.EndDate)
+	report, err := fetcher.Fetch(opts.Orgs, opts.Username, opts.StartDate, opts.EndDate)
 	if err != nil {
 		spin.Fail("Failed to fetch PRs")
 		return fmt.Errorf("failed to fetch PRs: %w", err)
 	}
 	spin.Success("Fetched PRs")
 
-	// Fetch previous period for comparison
+	// Fetch the comparison baseline and the previous periods for the trend (newest first)
+	var history []*pr.Report
 	var previousReport *pr.Report
-	spin = spinner.New("Fetching previous period...")
-	spin.Start()
-	prevStartDate, prevEndDate := period.CalcPrevious(opts.StartDate, opts.EndDate, opts.PeriodType)
-	previousReport, err = fetcher.Fetch(opts.Org, opts.Username, prevStartDate, prevEndDate)
-	if err != nil {
-		spin.Fail("Previous period unavailable")
-		previousReport = nil
+	if comparison.Baseline == period.BaselinePrevious {
+		history = fetchHistory(fetcher, cfg, opts)
+		previousReport = history[0]
 	} else {
-		spin.Success("Fetched previous period")
+		spin = spinner.New("Fetching comparison period...")
+		spin.Start()
+		previousReport, err = fetcher.Fetch(opts.Orgs, opts.Username, baseStart, baseEnd)
+		if err != nil {
+			spin.Fail("Comparison period unavailable")
+			previousReport = nil
+		} else {
+			spin.Success("Fetched comparison period")
+		}
+		if cfg.TrendPeriods > 1 {
+			history = fetchHistory(fetcher, cfg, opts)
+		}
+	}
+
+	if *saveSnapshot != "" {
+		if err := snapshot.Save(*saveSnapshot, &snapshot.Snapshot{
+			Report:        report,
+			Previous:      previousReport,
+			History:       history,
+			BaselineLabel: comparison.Label(),
+		}); err != nil {
+			return fmt.Errorf("failed to save snapshot: %w", err)
+		}
+		fmt.Fprintf(os.Stderr, "✓ Saved snapshot to %s\n", *saveSnapshot)
+	}
+
+	return renderReport(cfg, opts, report, previousReport,
+		render.WithHistory(history...),
+		render.WithBaselineLabel(comparison.Label()),
+	)
+}
+
+// runSnapshot は保存済みのスナップショットを GitHub にアクセスせずに出力する
+func runSnapshot(cfg *config.Config) error {
+	snap, err := snapshot.Load(*snapshotFile)
+	if err != nil {
+		return fmt.Errorf("failed to load snapshot: %w", err)
+	}
+
+	opts, err := prompt.ResolveOutput(cfg, cliFlags, snap.Report.StartDate, snap.Report.EndDate)
+	if err != nil {
+		return err
+	}
+
+	renderOpts := []render.Option{render.WithHistory(snap.History...)}
+	if snap.BaselineLabel != "" {
+		renderOpts = append(renderOpts, render.WithBaselineLabel(snap.BaselineLabel))
+	}
+	return renderReport(cfg, opts, snap.Report, snap.Previous, renderOpts...)
+}
+
+// renderReport はレポートを opts.Format の形式で出力する
+func renderReport(cfg *config.Config, opts *prompt.Options, report, previousReport *pr.Report, renderOpts ...render.Option) error {
+	markdownSections, err := render.ParseMarkdownSections(cfg.MarkdownSections)
+	if err != nil {
+		return fmt.Errorf("%w: --sections: %v", apperrors.ErrInvalidOption, err)
+	}
+	chartJS, err := render.ParseChartJSMode(cfg.ChartJS)
+	if err != nil {
+		return fmt.Errorf("%w: --chartjs: %v", apperrors.ErrInvalidOption, err)
+	}
+	chartMode, err := render.ParseChartMode(cfg.MarkdownCharts)
+	if err != nil {
+		return fmt.Errorf("%w: --charts: %v", apperrors.ErrInvalidOption, err)
+	}
+	if opts.Format != "markdown" {
+		chartMode = render.ChartNone
+	}
+	if chartMode == render.ChartFiles && opts.OutputPath == "" {
+		return fmt.Errorf("%w: --charts files cannot be used with stdout output; use --charts inline or --output", apperrors.ErrInvalidOption)
+	}
+	chartPrefix := chartFilePrefix(opts.OutputPath)
+	renderOpts = append(renderOpts,
+		render.WithBusinessCalendar(cfg.BusinessCalendar),
+		render.WithMarkdownCharts(chartMode, chartPrefix),
+		render.WithMarkdownSections(markdownSections...),
+		render.WithTemplate(reportTemplate),
+		render.WithChartJS(chartJS),
+	)
+	if opts.Format == "tsv" {
+		renderOpts = append(renderOpts, render.WithDelimiter('\t'))
+	}
+	if *dailyCSV != "" {
+		dailyPath := *dailyCSV
+		if dailyPath == "-" {
+			// -output と同じく "-" は標準出力を表す
+			if writesReportToStdout(cfg, opts) {
+				return fmt.Errorf("%w: --daily-csv - cannot be used when the report is also written to stdout; use --output", apperrors.ErrInvalidOption)
+			}
+			dailyPath = ""
+		}
+		if err := writeOutput(dailyPath, func(w io.Writer) error {
+			return render.RenderDailyStatsCSV(w, report, renderOpts...)
+		}); err != nil {
+			return err
+		}
 	}
 
 	switch opts.Format {
 	case "browser":
-		return server.Serve(report, previousReport)
+		return server.NewServer(server.WithRenderOptions(renderOpts...)).ServeReport(report, previousReport)
 	case "html":
 		return writeOutput(opts.OutputPath, func(w io.Writer) error {
-			return render.RenderHTML(w, report, previousReport)
+			return render.RenderHTML(w, report, previousReport, renderOpts...)
+		})
+	case "json":
+		return writeOutput(opts.OutputPath, func(w io.Writer) error {
+			return render.RenderJSON(w, report, previousReport, renderOpts...)
+		})
+	case "csv", "tsv":
+		return writeOutput(opts.OutputPath, func(w io.Writer) error {
+			return render.RenderCSV(w, report, renderOpts...)
 		})
+	case "slack", "slack-mrkdwn":
+		return renderSlack(cfg, opts, report, previousReport, renderOpts)
 	default: // markdown
+		if err := writeOutput(opts.OutputPath, func(w io.Writer) error {
+			return render.RenderMarkdown(w, report, previousReport, renderOpts...)
+		}); err != nil {
+			return err
+		}
+		if chartMode != render.ChartFiles {
+			return nil
+		}
+		// Markdown から相対パスで参照できるよう、レポートと同じディレクトリに保存する
+		for _, c := range render.RenderCharts(report, renderOpts...) {
+			if err := writeOutput(filepath.Join(filepath.Dir(opts.OutputPath), c.FileName(chartPrefix)), func(w io.Writer) error {
+				_, err := w.Write(c.SVG)
+				return err
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// renderSlack は Slack 形式で出力する。Webhook の URL が設定されている場合は送信し、
+// --output を指定した場合のみファイルにも保存する
+func renderSlack(cfg *config.Config, opts *prompt.Options, report, previousReport *pr.Report, renderOpts []render.Option) error {
+	renderer := render.RenderSlack
+	if opts.Format == "slack-mrkdwn" {
+		renderer = render.RenderSlackMrkdwn
+	}
+	if cfg.SlackWebhookURL == "" {
 		return writeOutput(opts.OutputPath, func(w io.Writer) error {
-			return render.RenderMarkdown(w, report)
+			return renderer(w, report, previousReport, renderOpts...)
 		})
 	}
+
+	var buf bytes.Buffer
+	if err := renderer(&buf, report, previousReport, renderOpts...); err != nil {
+		return err
+	}
+	webhook := slack.NewWebhook(cfg.SlackWebhookURL)
+	post := func() error { return webhook.Post(buf.Bytes()) }
+	if opts.Format == "slack-mrkdwn" {
+		post = func() error { return webhook.PostText(buf.String()) }
+	}
+	if err := post(); err != nil {
+		return err
+	}
+	fmt.Fprintln(os.Stderr, "✓ Posted to Slack")
+
+	if cliFlags.Output == "" {
+		return nil
+	}
+	return writeOutput(opts.OutputPath, func(w io.Writer) error {
+		_, err := w.Write(buf.Bytes())
+		return err
+	})
+}
+
+// writesReportToStdout はレポートを標準出力に書き込むかどうかを返す
+func writesReportToStdout(cfg *config.Config, opts *prompt.Options) bool {
+	switch {
+	case opts.Format == "browser":
+		return false
+	case strings.HasPrefix(opts.Format, "slack") && cfg.SlackWebhookURL != "":
+		// Webhook に投稿する場合は -output を指定した時だけ書き込む
+		return cliFlags.Output != "" && opts.OutputPath == ""
+	default:
+		return opts.OutputPath == ""
+	}
+}
+
+// chartFilePrefix は出力ファイル名から SVG グラフのファイル名の接頭辞を返す (例: out/2025-01.md → "2025-01-")
+func chartFilePrefix(outputPath string) string {
+	if outputPath == "" {
+		return ""
+	}
+	base := filepath.Base(outputPath)
+	return strings.TrimSuffix(base, filepath.Ext(base)) + "-"
+}
+
+// fetchHistory は直前の期間から遡って cfg.TrendPeriods 個の期間のレポートを新しい順に取得する。
+// 取得に失敗した期間以降は nil になる
+func fetchHistory(fetcher *pr.Fetcher, cfg *config.Config, opts *prompt.Options) []*pr.Report {
+	history := make([]*pr.Report, cfg.TrendPeriods)
+
+	label := "previous period"
+	if cfg.TrendPeriods > 1 {
+		label = fmt.Sprintf("%d previous periods", cfg.TrendPeriods)
+	}
+	spin := spinner.New("Fetching " + label + "...")
+	spin.Start()
+
+	start, end := opts.StartDate, opts.EndDate
+	for i := range history {
+		start, end = cfg.Calendar.CalcPrevious(start, end, opts.PeriodType)
+		report, err := fetcher.Fetch(opts.Orgs, opts.Username, start, end)
+		if err != nil {
+			if i == 0 {
+				spin.Fail("Previous period unavailable")
+			} else {
+				spin.Fail(fmt.Sprintf("Fetched %d of %d previous periods", i, cfg.TrendPeriods))
+			}
+			return history
+		}
+		history[i] = report
+	}
+
+	spin.Success("Fetched " + label)
+	return history
+}
+
+// resolveOptions はフラグと環境変数から実行オプションを決定する。
+// 必須の値が揃っていない場合は対話プロンプトで補うが、標準入力が端末でない場合はエラーにする
+func resolveOptions(cfg *config.Config, defaultUsername string) (*prompt.Options, error) {
+	opts, err := prompt.Resolve(cfg, defaultUsername, cliFlags, time.Now().In(cfg.Location))
+	if !errors.Is(err, apperrors.ErrMissingOptions) {
+		return opts, err
+	}
+	if !prompt.IsTerminal() {
+		return nil, fmt.Errorf("%w (stdin is not a terminal, so shiraberu cannot prompt for them)", err)
+	}
+
+	return prompt.RunWithFlags(cfg, defaultUsername, cliFlags)
 }
 
 // writeOutput はレンダリング結果をファイルまたは標準出力に書き込む
@@ -117,20 +474,23 @@ func writeOutput(path string, renderer func(io.Writer) error) error {
 		return err
 	}
 
-	fmt.Printf("✓ Saved to %s\n", path)
+	// 標準出力へのレポート出力と混ざらないよう、スピナーと同じく標準エラー出力に表示する
+	fmt.Fprintf(os.Stderr, "✓ Saved to %s\n", path)
 	return nil
 }
 
-func runDemo() error {
+// runDemo はサンプルデータのレポートをブラウザで表示する。-chartjs などの出力のオプションは通常の実行と同じく適用される
+func runDemo(cfg *config.Config) error {
 	fmt.Println("Demo mode: Generating sample data...")
 
 	// Generate demo data for last 30 days
-	endDate := time.Now()
+	endDate := time.Now().In(cfg.Location)
 	startDate := endDate.AddDate(0, 0, -30)
 
 	report, previousReport := demo.GenerateReport(startDate, endDate)
 
 	fmt.Printf("Generated %d days of demo data\n", len(report.Days))
 
-	return server.Serve(report, previousReport)
+	opts := &prompt.Options{StartDate: startDate, EndDate: endDate, PeriodType: period.TypeCustom, Format: "browser"}
+	return renderReport(cfg, opts, report, previousReport)
 }